@@ -5,10 +5,15 @@
 package elastic
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -70,6 +75,74 @@ func TestBulkProcessorCommitOnBulkActions(t *testing.T) {
 	)
 }
 
+func TestBulkProcessorConcurrentAdd(t *testing.T) {
+	var received int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every other line of the NDJSON body is an action line
+		var items []string
+		scanner := bufio.NewScanner(r.Body)
+		for i := 0; scanner.Scan(); i++ {
+			if i%2 == 0 {
+				items = append(items, `{"index":{"_index":"tweets","status":201}}`)
+			}
+		}
+		atomic.AddInt64(&received, int64(len(items)))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"took":1,"errors":false,"items":[%s]}`, strings.Join(items, ","))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := client.BulkProcessor().
+		Name("ConcurrentAdd").
+		Workers(4).
+		BulkActions(10).
+		BulkSize(-1).
+		Stats(true).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const numGoroutines, numDocsPerGoroutine = 8, 125
+	var wg sync.WaitGroup
+	for g := 0; g < numGoroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < numDocsPerGoroutine; i++ {
+				tw := tweet{User: "olivere", Message: fmt.Sprintf("%d.%d", g, i)}
+				p.Add(NewBulkIndexRequest().Index("tweets").Id(fmt.Sprintf("%d.%d", g, i)).Doc(tw))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	// Close flushes the remaining requests
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	const numDocs = numGoroutines * numDocsPerGoroutine
+	if want, have := int64(numDocs), atomic.LoadInt64(&received); want != have {
+		t.Errorf("expected %d documents sent; got: %d", want, have)
+	}
+	stats := p.Stats()
+	if want, have := int64(numDocs), stats.Indexed; want != have {
+		t.Errorf("expected Indexed=%d; got: %d", want, have)
+	}
+	if want, have := int64(numDocs), stats.Succeeded; want != have {
+		t.Errorf("expected Succeeded=%d; got: %d", want, have)
+	}
+	if want, have := int64(0), stats.Failed; want != have {
+		t.Errorf("expected Failed=%d; got: %d", want, have)
+	}
+}
+
 func TestBulkProcessorCommitOnBulkSize(t *testing.T) {
 	//client := setupTestClientAndCreateIndexAndLog(t, SetTraceLog(log.New(os.Stdout, "", 0)))
 	client := setupTestClientAndCreateIndex(t)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -524,6 +525,78 @@ func TestBulkContentType(t *testing.T) {
 	}
 }
 
+func TestBulkRequestBodyAndResponseItems(t *testing.T) {
+	var (
+		path string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{
+			"took": 7,
+			"errors": true,
+			"items": [
+				{"index": {"_index": "tweets", "_id": "1", "_version": 1, "result": "created", "status": 201}},
+				{"delete": {"_index": "tweets", "_id": "2", "status": 404, "result": "not_found"}},
+				{"index": {"_index": "tweets", "_id": "3", "status": 400, "error": {"type": "mapper_parsing_exception", "reason": "failed to parse field [retweets]"}}}
+			]
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Bulk().
+		Add(NewBulkIndexRequest().Index("tweets").Id("1").Doc(tweet{User: "olivere", Message: "Welcome"})).
+		Add(NewBulkDeleteRequest().Index("tweets").Id("2")).
+		Add(NewBulkIndexRequest().Index("tweets").Id("3").Doc(map[string]interface{}{"retweets": "many"})).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := "/_bulk", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"index":{"_index":"tweets","_id":"1"}}
+{"user":"olivere","message":"Welcome","retweets":0,"created":"0001-01-01T00:00:00Z"}
+{"delete":{"_index":"tweets","_id":"2"}}
+{"index":{"_index":"tweets","_id":"3"}}
+{"retweets":"many"}
+`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	if want, have := 7, res.Took; want != have {
+		t.Errorf("expected took = %d; got: %d", want, have)
+	}
+	if !res.Errors {
+		t.Error("expected errors = true")
+	}
+	failed := res.Failed()
+	if want, have := 2, len(failed); want != have {
+		t.Fatalf("expected %d failed items; got: %d", want, have)
+	}
+	if want, have := http.StatusBadRequest, failed[1].Status; want != have {
+		t.Errorf("expected status = %d; got: %d", want, have)
+	}
+	if failed[1].Error == nil {
+		t.Fatal("expected error details")
+	}
+	if want, have := "mapper_parsing_exception", failed[1].Error.Type; want != have {
+		t.Errorf("expected error type %q; got: %q", want, have)
+	}
+	if want, have := "failed to parse field [retweets]", failed[1].Error.Reason; want != have {
+		t.Errorf("expected error reason %q; got: %q", want, have)
+	}
+}
+
 // -- Benchmarks --
 
 var benchmarkBulkEstimatedSizeInBytes int64
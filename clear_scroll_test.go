@@ -6,7 +6,9 @@ package elastic
 
 import (
 	"context"
-	_ "net/http"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -85,3 +87,67 @@ func TestClearScrollValidate(t *testing.T) {
 		t.Fatalf("expected result to be nil; got: %v", res)
 	}
 }
+
+func TestClearScrollRequestBodyAndResponse(t *testing.T) {
+	tests := []struct {
+		ScrollIds    []string
+		Response     string
+		ExpectedBody string
+		NumFreed     int
+	}{
+		{
+			[]string{"c2Nyb2xsMQ==", "c2Nyb2xsMg=="},
+			`{"succeeded":true,"num_freed":2}`,
+			`{"scroll_id":["c2Nyb2xsMQ==","c2Nyb2xsMg=="]}`,
+			2,
+		},
+		{
+			[]string{"_all"},
+			`{"succeeded":true,"num_freed":5}`,
+			`{"scroll_id":["_all"]}`,
+			5,
+		},
+	}
+
+	for i, tt := range tests {
+		var (
+			method string
+			path   string
+			body   string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			path = r.URL.Path
+			data, _ := ioutil.ReadAll(r.Body)
+			body = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tt.Response))
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		res, err := client.ClearScroll(tt.ScrollIds...).Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := "DELETE", method; want != have {
+			t.Errorf("#%d: expected method %q; got: %q", i, want, have)
+		}
+		if want, have := "/_search/scroll/", path; want != have {
+			t.Errorf("#%d: expected path %q; got: %q", i, want, have)
+		}
+		if want, have := tt.ExpectedBody, body; want != have {
+			t.Errorf("#%d: expected body\n%s\n,got:\n%s", i, want, have)
+		}
+		if !res.Succeeded {
+			t.Errorf("#%d: expected Succeeded = true", i)
+		}
+		if want, have := tt.NumFreed, res.NumFreed; want != have {
+			t.Errorf("#%d: expected NumFreed = %d; got: %d", i, want, have)
+		}
+	}
+}
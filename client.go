@@ -7,6 +7,7 @@ package elastic
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -79,6 +80,10 @@ const (
 	// DefaultGzipEnabled specifies if gzip compression is enabled by default.
 	DefaultGzipEnabled = false
 
+	// DefaultAcceptGzipEnabled specifies if gzip-compressed responses are
+	// requested by default.
+	DefaultAcceptGzipEnabled = false
+
 	// off is used to disable timeouts.
 	off = -1 * time.Second
 )
@@ -139,10 +144,13 @@ type Client struct {
 	basicAuth                 bool            // indicates whether to send HTTP Basic Auth credentials
 	basicAuthUsername         string          // username for HTTP Basic Auth
 	basicAuthPassword         string          // password for HTTP Basic Auth
+	apiKey                    string          // base64-encoded API key credentials, sent as "Authorization: ApiKey ..."
 	sendGetBodyAs             string          // override for when sending a GET with a body
 	gzipEnabled               bool            // gzip compression enabled or disabled (default)
+	acceptGzipEnabled         bool            // request gzip-compressed responses or not (default)
 	requiredPlugins           []string        // list of required plugins
 	retrier                   Retrier         // strategy for retries
+	retryStatusCodes          []int           // HTTP status codes where to retry
 }
 
 // NewClient creates a new client to work with Elasticsearch.
@@ -243,6 +251,7 @@ func NewSimpleClient(options ...ClientOptionFunc) (*Client, error) {
 		snifferStop:               make(chan bool),
 		sendGetBodyAs:             DefaultSendGetBodyAs,
 		gzipEnabled:               DefaultGzipEnabled,
+		acceptGzipEnabled:         DefaultAcceptGzipEnabled,
 		retrier:                   noRetries, // no retries by default
 	}
 
@@ -328,6 +337,7 @@ func DialContext(ctx context.Context, options ...ClientOptionFunc) (*Client, err
 		snifferStop:               make(chan bool),
 		sendGetBodyAs:             DefaultSendGetBodyAs,
 		gzipEnabled:               DefaultGzipEnabled,
+		acceptGzipEnabled:         DefaultAcceptGzipEnabled,
 		retrier:                   noRetries, // no retries by default
 	}
 
@@ -491,6 +501,22 @@ func SetBasicAuth(username, password string) ClientOptionFunc {
 	}
 }
 
+// SetApiKey can be used to specify the API key credentials to use when
+// making HTTP requests to Elasticsearch. The id and apiKey are returned by
+// the Create API Key API; they are sent base64-encoded in an
+// "Authorization: ApiKey ..." header. API key credentials take precedence
+// over HTTP Basic Auth credentials if both are specified.
+func SetApiKey(id, apiKey string) ClientOptionFunc {
+	return func(c *Client) error {
+		if id == "" && apiKey == "" {
+			c.apiKey = ""
+		} else {
+			c.apiKey = base64.StdEncoding.EncodeToString([]byte(id + ":" + apiKey))
+		}
+		return nil
+	}
+}
+
 // SetURL defines the URL endpoints of the Elasticsearch nodes. Notice that
 // when sniffing is enabled, these URLs are used to initially sniff the
 // cluster on startup.
@@ -647,6 +673,15 @@ func SetGzip(enabled bool) ClientOptionFunc {
 	}
 }
 
+// SetAcceptGzip enables or disables requesting gzip-compressed responses
+// via "Accept-Encoding: gzip" (disabled by default).
+func SetAcceptGzip(enabled bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.acceptGzipEnabled = enabled
+		return nil
+	}
+}
+
 // SetDecoder sets the Decoder to use when decoding data from Elasticsearch.
 // DefaultDecoder is used by default.
 func SetDecoder(decoder Decoder) ClientOptionFunc {
@@ -720,6 +755,17 @@ func SetRetrier(retrier Retrier) ClientOptionFunc {
 	}
 }
 
+// SetRetryStatusCodes specifies the HTTP status codes on which the
+// Retrier is consulted, in addition to network errors. It is empty by
+// default, i.e. only network errors are retried. A common choice is
+// http.StatusTooManyRequests and http.StatusServiceUnavailable.
+func SetRetryStatusCodes(statusCodes ...int) ClientOptionFunc {
+	return func(c *Client) error {
+		c.retryStatusCodes = statusCodes
+		return nil
+	}
+}
+
 // String returns a string representation of the client status.
 func (c *Client) String() string {
 	c.connsMu.Lock()
@@ -952,6 +998,9 @@ func (c *Client) sniffNode(ctx context.Context, url string) []*conn {
 	if c.basicAuth {
 		req.SetBasicAuth(c.basicAuthUsername, c.basicAuthPassword)
 	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+c.apiKey)
+	}
 	c.mu.RUnlock()
 
 	res, err := c.c.Do((*http.Request)(req).WithContext(ctx))
@@ -1073,6 +1122,7 @@ func (c *Client) healthcheck(parentCtx context.Context, timeout time.Duration, f
 	basicAuth := c.basicAuth
 	basicAuthUsername := c.basicAuthUsername
 	basicAuthPassword := c.basicAuthPassword
+	apiKey := c.apiKey
 	c.mu.RUnlock()
 
 	c.connsMu.RLock()
@@ -1096,6 +1146,9 @@ func (c *Client) healthcheck(parentCtx context.Context, timeout time.Duration, f
 			if basicAuth {
 				req.SetBasicAuth(basicAuthUsername, basicAuthPassword)
 			}
+			if apiKey != "" {
+				req.Header.Set("Authorization", "ApiKey "+apiKey)
+			}
 			res, err := c.c.Do((*http.Request)(req).WithContext(ctx))
 			if res != nil {
 				status = res.StatusCode
@@ -1135,6 +1188,7 @@ func (c *Client) startupHealthcheck(parentCtx context.Context, timeout time.Dura
 	basicAuth := c.basicAuth
 	basicAuthUsername := c.basicAuthUsername
 	basicAuthPassword := c.basicAuthPassword
+	apiKey := c.apiKey
 	c.mu.Unlock()
 
 	// If we don't get a connection after "timeout", we bail.
@@ -1150,6 +1204,9 @@ func (c *Client) startupHealthcheck(parentCtx context.Context, timeout time.Dura
 			if basicAuth {
 				req.SetBasicAuth(basicAuthUsername, basicAuthPassword)
 			}
+			if apiKey != "" {
+				req.Header.Set("Authorization", "ApiKey "+apiKey)
+			}
 			ctx, cancel := context.WithTimeout(parentCtx, timeout)
 			defer cancel()
 			req = req.WithContext(ctx)
@@ -1238,15 +1295,16 @@ func (c *Client) mustActiveConn() error {
 
 // PerformRequestOptions must be passed into PerformRequest.
 type PerformRequestOptions struct {
-	Method          string
-	Path            string
-	Params          url.Values
-	Body            interface{}
-	ContentType     string
-	IgnoreErrors    []int
-	Retrier         Retrier
-	Headers         http.Header
-	MaxResponseSize int64
+	Method           string
+	Path             string
+	Params           url.Values
+	Body             interface{}
+	ContentType      string
+	IgnoreErrors     []int
+	Retrier          Retrier
+	RetryStatusCodes []int
+	Headers          http.Header
+	MaxResponseSize  int64
 }
 
 // PerformRequest does a HTTP request to Elasticsearch.
@@ -1263,12 +1321,18 @@ func (c *Client) PerformRequest(ctx context.Context, opt PerformRequestOptions)
 	basicAuth := c.basicAuth
 	basicAuthUsername := c.basicAuthUsername
 	basicAuthPassword := c.basicAuthPassword
+	apiKey := c.apiKey
 	sendGetBodyAs := c.sendGetBodyAs
 	gzipEnabled := c.gzipEnabled
+	acceptGzipEnabled := c.acceptGzipEnabled
 	retrier := c.retrier
 	if opt.Retrier != nil {
 		retrier = opt.Retrier
 	}
+	retryStatusCodes := c.retryStatusCodes
+	if opt.RetryStatusCodes != nil {
+		retryStatusCodes = opt.RetryStatusCodes
+	}
 	c.mu.RUnlock()
 
 	var err error
@@ -1305,7 +1369,9 @@ func (c *Client) PerformRequest(ctx context.Context, opt PerformRequestOptions)
 				return nil, err
 			}
 			retried = true
-			time.Sleep(wait)
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
 			continue // try again
 		}
 		if err != nil {
@@ -1322,9 +1388,15 @@ func (c *Client) PerformRequest(ctx context.Context, opt PerformRequestOptions)
 		if basicAuth {
 			req.SetBasicAuth(basicAuthUsername, basicAuthPassword)
 		}
+		if apiKey != "" {
+			req.Header.Set("Authorization", "ApiKey "+apiKey)
+		}
 		if opt.ContentType != "" {
 			req.Header.Set("Content-Type", opt.ContentType)
 		}
+		if acceptGzipEnabled {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
 
 		if len(opt.Headers) > 0 {
 			for key, value := range opt.Headers {
@@ -1366,9 +1438,32 @@ func (c *Client) PerformRequest(ctx context.Context, opt PerformRequestOptions)
 				return nil, err
 			}
 			retried = true
-			time.Sleep(wait)
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
 			continue // try again
 		}
+		if containsInt(retryStatusCodes, res.StatusCode) {
+			n++
+			wait, ok, rerr := retrier.Retry(ctx, n, (*http.Request)(req), res, nil)
+			if rerr != nil || ok {
+				c.dumpResponse(res)
+				if res.Body != nil {
+					res.Body.Close()
+				}
+			}
+			if rerr != nil {
+				return nil, rerr
+			}
+			if ok {
+				retried = true
+				if err := sleepContext(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue // try again
+			}
+			// No more retries: return the response and its error below
+		}
 		if res.Body != nil {
 			defer res.Body.Close()
 		}
@@ -1410,6 +1505,32 @@ func (c *Client) PerformRequest(ctx context.Context, opt PerformRequestOptions)
 	return resp, nil
 }
 
+// sleepContext waits for the given duration or until ctx is done,
+// whichever comes first. It returns the context error in the latter case.
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// containsInt returns true if x is in list.
+func containsInt(list []int, x int) bool {
+	for _, v := range list {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
 // -- Document APIs --
 
 // Index a document.
@@ -1506,7 +1627,11 @@ func (c *Client) Explain(index, typ, id string) *ExplainService {
 	return NewExplainService(c).Index(index).Type(typ).Id(id)
 }
 
-// TODO Search Template
+// SearchTemplate executes a search based on an inline or stored search template.
+func (c *Client) SearchTemplate(indices ...string) *SearchTemplateService {
+	return NewSearchTemplateService(c).Index(indices...)
+}
+
 // TODO Search Exists API
 
 // Validate allows a user to validate a potentially expensive query without executing it.
@@ -2082,3 +2207,26 @@ func (c *Client) WaitForGreenStatus(timeout string) error {
 func (c *Client) WaitForYellowStatus(timeout string) error {
 	return c.WaitForStatus("yellow", timeout)
 }
+
+// WaitForTask polls the Task Management API every pollInterval until the
+// task with the given id (in the form node_id:task_number) is completed,
+// or ctx is canceled. It returns the response of the last poll, which
+// contains the outcome of the task in Response or Error. A non-positive
+// pollInterval is rejected with an error.
+func (c *Client) WaitForTask(ctx context.Context, taskId string, pollInterval time.Duration) (*TasksGetTaskResponse, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("elastic: poll interval must be positive; got: %v", pollInterval)
+	}
+	for {
+		res, err := c.TasksGetTask().TaskId(taskId).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.Completed {
+			return res, nil
+		}
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -117,3 +119,65 @@ func TestClusterHealthWaitForStatus(t *testing.T) {
 		t.Fatalf("expected no error; got: %v", err)
 	}
 }
+
+func TestClusterHealthDecodeResponse(t *testing.T) {
+	tests := []struct {
+		Response           string
+		Status             string
+		NumberOfNodes      int
+		ActiveShards       int
+		RelocatingShards   int
+		InitializingShards int
+		UnassignedShards   int
+	}{
+		{
+			`{"cluster_name":"elasticsearch","status":"green","timed_out":false,"number_of_nodes":3,"number_of_data_nodes":3,"active_primary_shards":5,"active_shards":10,"relocating_shards":0,"initializing_shards":0,"unassigned_shards":0}`,
+			"green", 3, 10, 0, 0, 0,
+		},
+		{
+			`{"cluster_name":"elasticsearch","status":"yellow","timed_out":false,"number_of_nodes":1,"number_of_data_nodes":1,"active_primary_shards":5,"active_shards":5,"relocating_shards":1,"initializing_shards":2,"unassigned_shards":5}`,
+			"yellow", 1, 5, 1, 2, 5,
+		},
+	}
+
+	for i, tt := range tests {
+		var uri string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			uri = r.URL.RequestURI()
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tt.Response))
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		res, err := client.ClusterHealth().WaitForStatus("green").WaitForNodes(">=1").Timeout("5s").Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := "/_cluster/health?timeout=5s&wait_for_nodes=%3E%3D1&wait_for_status=green", uri; want != have {
+			t.Errorf("#%d: expected URI %q; got: %q", i, want, have)
+		}
+		if want, have := tt.Status, res.Status; want != have {
+			t.Errorf("#%d: expected status %q; got: %q", i, want, have)
+		}
+		if want, have := tt.NumberOfNodes, res.NumberOfNodes; want != have {
+			t.Errorf("#%d: expected number_of_nodes %d; got: %d", i, want, have)
+		}
+		if want, have := tt.ActiveShards, res.ActiveShards; want != have {
+			t.Errorf("#%d: expected active_shards %d; got: %d", i, want, have)
+		}
+		if want, have := tt.RelocatingShards, res.RelocatingShards; want != have {
+			t.Errorf("#%d: expected relocating_shards %d; got: %d", i, want, have)
+		}
+		if want, have := tt.InitializingShards, res.InitializingShards; want != have {
+			t.Errorf("#%d: expected initializing_shards %d; got: %d", i, want, have)
+		}
+		if want, have := tt.UnassignedShards, res.UnassignedShards; want != have {
+			t.Errorf("#%d: expected unassigned_shards %d; got: %d", i, want, have)
+		}
+	}
+}
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -50,6 +53,64 @@ func TestCountURL(t *testing.T) {
 	}
 }
 
+func TestCountRequestAndResponse(t *testing.T) {
+	var (
+		uri  string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		count := "42"
+		if body != "" {
+			count = "7"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count":` + count + `,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Count all documents
+	count, err := client.Count("tweets").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(42), count; want != have {
+		t.Errorf("expected count = %d; got: %d", want, have)
+	}
+	if want, have := "/tweets/_count", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	if want, have := "", body; want != have {
+		t.Errorf("expected no body; got: %s", have)
+	}
+
+	// Count filtered documents
+	count, err = client.Count("tweets").
+		Query(NewTermQuery("user", "olivere")).
+		MinScore(0.5).
+		Routing("olivere").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(7), count; want != have {
+		t.Errorf("expected count = %d; got: %d", want, have)
+	}
+	if want, have := "/tweets/_count?min_score=0.5&routing=olivere", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	if want, have := `{"query":{"term":{"user":"olivere"}}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+}
+
 func TestCount(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
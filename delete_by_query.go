@@ -620,10 +620,11 @@ func (s *DeleteByQueryService) Do(ctx context.Context) (*BulkIndexByScrollRespon
 
 	// Get response
 	res, err := s.client.PerformRequest(ctx, PerformRequestOptions{
-		Method: "POST",
-		Path:   path,
-		Params: params,
-		Body:   body,
+		Method:       "POST",
+		Path:         path,
+		Params:       params,
+		Body:         body,
+		IgnoreErrors: []int{http.StatusConflict},
 	})
 	if err != nil {
 		return nil, err
@@ -675,10 +676,11 @@ func (s *DeleteByQueryService) DoAsync(ctx context.Context) (*StartTaskResult, e
 
 	// Get HTTP response
 	res, err := s.client.PerformRequest(ctx, PerformRequestOptions{
-		Method: "POST",
-		Path:   path,
-		Params: params,
-		Body:   body,
+		Method:       "POST",
+		Path:         path,
+		Params:       params,
+		Body:         body,
+		IgnoreErrors: []int{http.StatusConflict},
 	})
 	if err != nil {
 		return nil, err
@@ -696,6 +698,7 @@ func (s *DeleteByQueryService) DoAsync(ctx context.Context) (*StartTaskResult, e
 // DeleteByQueryService and UpdateByQueryService.
 type BulkIndexByScrollResponse struct {
 	Header           http.Header `json:"-"`
+	TaskId           string      `json:"task,omitempty"` // set when WaitForCompletion(false) was used
 	Took             int64       `json:"took"`
 	SliceId          *int64      `json:"slice_id,omitempty"`
 	TimedOut         bool        `json:"timed_out"`
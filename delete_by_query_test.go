@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -209,3 +212,65 @@ func TestDeleteByQueryAsync(t *testing.T) {
 		t.Fatal("expected task status result != nil")
 	}
 }
+
+func TestDeleteByQueryWithVersionConflicts(t *testing.T) {
+	var (
+		uri  string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{
+			"took": 12,
+			"timed_out": false,
+			"total": 3,
+			"deleted": 2,
+			"batches": 1,
+			"version_conflicts": 1,
+			"noops": 0,
+			"retries": {"bulk": 0, "search": 0},
+			"failures": [{
+				"index": "tweets",
+				"type": "_doc",
+				"id": "3",
+				"cause": {"type": "version_conflict_engine_exception", "reason": "[3]: version conflict"},
+				"status": 409
+			}]
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.DeleteByQuery("tweets").Query(NewTermQuery("user", "olivere")).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets/_delete_by_query", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	if want, have := `{"query":{"term":{"user":"olivere"}}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	if want, have := int64(2), res.Deleted; want != have {
+		t.Errorf("expected Deleted = %d; got: %d", want, have)
+	}
+	if want, have := int64(1), res.Batches; want != have {
+		t.Errorf("expected Batches = %d; got: %d", want, have)
+	}
+	if want, have := int64(1), res.VersionConflicts; want != have {
+		t.Errorf("expected VersionConflicts = %d; got: %d", want, have)
+	}
+	if want, have := 1, len(res.Failures); want != have {
+		t.Fatalf("expected %d failures; got: %d", want, have)
+	}
+	if want, have := http.StatusConflict, res.Failures[0].Status; want != have {
+		t.Errorf("expected failure status = %d; got: %d", want, have)
+	}
+}
@@ -45,7 +45,12 @@ func createResponseError(res *http.Response) error {
 	if res.Body == nil {
 		return &Error{Status: res.StatusCode}
 	}
-	data, err := ioutil.ReadAll(res.Body)
+	body, err := responseBody(res)
+	if err != nil {
+		return &Error{Status: res.StatusCode}
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
 	if err != nil {
 		return &Error{Status: res.StatusCode}
 	}
@@ -169,6 +174,7 @@ type ShardsInfo struct {
 	Total      int             `json:"total"`
 	Successful int             `json:"successful"`
 	Failed     int             `json:"failed"`
+	Skipped    int             `json:"skipped,omitempty"`
 	Failures   []*ShardFailure `json:"failures,omitempty"`
 }
 
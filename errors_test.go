@@ -6,8 +6,11 @@ package elastic
 
 import (
 	"bufio"
+	"compress/gzip"
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 )
@@ -74,6 +77,50 @@ func TestResponseError(t *testing.T) {
 	}
 }
 
+func TestResponseErrorBadRequest(t *testing.T) {
+	raw := "HTTP/1.1 400 Bad Request\r\n" +
+		"\r\n" +
+		`{"error":{"root_cause":[{"type":"x_content_parse_exception","reason":"[1:52] [completion] unknown field [fild], parser not found"}],"type":"x_content_parse_exception","reason":"[1:52] [completion] unknown field [fild], parser not found"},"status":400}` + "\r\n"
+	r := bufio.NewReader(strings.NewReader(raw))
+
+	req, err := http.NewRequest("POST", "/songs/_search", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkResponse(req, resp)
+	if err == nil {
+		t.Fatalf("expected error; got: %v", err)
+	}
+
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatal("expected error to be of type *elastic.Error")
+	}
+	if got, want := e.Status, http.StatusBadRequest; got != want {
+		t.Fatalf("expected status code %d; got: %d", want, got)
+	}
+	if e.Details == nil {
+		t.Fatalf("expected error details; got: %v", e.Details)
+	}
+	if got, want := e.Details.Type, "x_content_parse_exception"; got != want {
+		t.Fatalf("expected error details type %q; got: %q", want, got)
+	}
+	if got, want := e.Details.Reason, "[1:52] [completion] unknown field [fild], parser not found"; got != want {
+		t.Fatalf("expected error details reason %q; got: %q", want, got)
+	}
+	if IsNotFound(err) {
+		t.Fatal("expected a 400 error not to be reported as not found")
+	}
+	if !IsStatusCode(err, http.StatusBadRequest) {
+		t.Fatalf("expected IsStatusCode(err, %d) to be true", http.StatusBadRequest)
+	}
+}
+
 func TestResponseErrorHTML(t *testing.T) {
 	raw := "HTTP/1.1 413 Request Entity Too Large\r\n" +
 		"\r\n" +
@@ -133,6 +180,63 @@ func TestResponseErrorWithIgnore(t *testing.T) {
 	}
 }
 
+func TestResponseErrorWithGzipContentEncoding(t *testing.T) {
+	tests := []struct {
+		StatusCode int
+		Body       string
+		Type       string
+	}{
+		{
+			http.StatusNotFound,
+			`{"error":{"type":"index_not_found_exception","reason":"no such index"},"status":404}`,
+			"index_not_found_exception",
+		},
+		{
+			http.StatusInternalServerError,
+			`{"error":{"type":"search_phase_execution_exception","reason":"all shards failed"},"status":500}`,
+			"search_phase_execution_exception",
+		},
+	}
+
+	for i, tt := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(tt.StatusCode)
+			gz := gzip.NewWriter(w)
+			gz.Write([]byte(tt.Body))
+			gz.Close()
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL), SetAcceptGzip(true))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		_, err = client.PerformRequest(context.Background(), PerformRequestOptions{
+			Method: "GET",
+			Path:   "/tweets/_search",
+		})
+		ts.Close()
+		if err == nil {
+			t.Fatalf("#%d: expected error", i)
+		}
+		e, ok := err.(*Error)
+		if !ok {
+			t.Fatalf("#%d: expected error to be of type *Error; got: %T", i, err)
+		}
+		if want, have := tt.StatusCode, e.Status; want != have {
+			t.Errorf("#%d: expected status %d; got: %d", i, want, have)
+		}
+		if e.Details == nil {
+			t.Fatalf("#%d: expected error details; got: %v", i, e.Details)
+		}
+		if want, have := tt.Type, e.Details.Type; want != have {
+			t.Errorf("#%d: expected type %q; got: %q", i, want, have)
+		}
+	}
+}
+
 func TestIsNotFound(t *testing.T) {
 	if got, want := IsNotFound(nil), false; got != want {
 		t.Errorf("expected %v; got: %v", want, got)
@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,6 +23,53 @@ func TestExists(t *testing.T) {
 	}
 }
 
+func TestExistsStatusCodes(t *testing.T) {
+	tests := []struct {
+		StatusCode int
+		Exists     bool
+		WantErr    bool
+	}{
+		{http.StatusOK, true, false},
+		{http.StatusNotFound, false, false},
+		{http.StatusInternalServerError, false, true},
+	}
+
+	for i, tt := range tests {
+		var (
+			method string
+			uri    string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			uri = r.URL.RequestURI()
+			w.WriteHeader(tt.StatusCode)
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		exists, err := client.Exists().Index("tweets").Id("1").Routing("olivere").Preference("_local").Do(context.TODO())
+		ts.Close()
+		if tt.WantErr && err == nil {
+			t.Errorf("#%d: expected error", i)
+		}
+		if !tt.WantErr && err != nil {
+			t.Errorf("#%d: expected no error; got: %v", i, err)
+		}
+		if want, have := tt.Exists, exists; want != have {
+			t.Errorf("#%d: expected exists = %v; got: %v", i, want, have)
+		}
+		if want, have := "HEAD", method; want != have {
+			t.Errorf("#%d: expected method %q; got: %q", i, want, have)
+		}
+		if want, have := "/tweets/_doc/1?preference=_local&routing=olivere", uri; want != have {
+			t.Errorf("#%d: expected URI %q; got: %q", i, want, have)
+		}
+	}
+}
+
 func TestExistsValidate(t *testing.T) {
 	client := setupTestClient(t)
 
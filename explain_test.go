@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -41,3 +44,74 @@ func TestExplain(t *testing.T) {
 		t.Errorf("expected matched to be %v; got: %v", true, expl.Matched)
 	}
 }
+
+func TestExplainMatchedAndNotMatched(t *testing.T) {
+	tests := []struct {
+		Id       string
+		Response string
+		Matched  bool
+		Value    float64
+	}{
+		{
+			"1",
+			`{"_index":"tweets","_type":"_doc","_id":"1","matched":true,"explanation":{"value":1.3862942,"description":"weight(user:olivere in 0) [PerFieldSimilarity], result of:","details":[{"value":1.3862942,"description":"score(freq=1.0), computed as boost * idf * tf from:","details":[]}]}}`,
+			true,
+			1.3862942,
+		},
+		{
+			"2",
+			`{"_index":"tweets","_type":"_doc","_id":"2","matched":false,"explanation":{"value":0.0,"description":"no matching term","details":[]}}`,
+			false,
+			0,
+		},
+	}
+
+	for i, tt := range tests {
+		var (
+			path string
+			body string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			data, _ := ioutil.ReadAll(r.Body)
+			body = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tt.Response))
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		res, err := client.Explain("tweets", "_doc", tt.Id).
+			Query(NewTermQuery("user", "olivere")).
+			Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := "/tweets/_explain/"+tt.Id, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := `{"query":{"term":{"user":"olivere"}}}`, body; want != have {
+			t.Errorf("case #%d: expected body\n%s\n,got:\n%s", i+1, want, have)
+		}
+		if want, have := tt.Id, res.Id; want != have {
+			t.Errorf("case #%d: expected Id = %q; got: %q", i+1, want, have)
+		}
+		if want, have := tt.Matched, res.Matched; want != have {
+			t.Errorf("case #%d: expected Matched = %v; got: %v", i+1, want, have)
+		}
+		if res.Explanation == nil {
+			t.Fatalf("case #%d: expected explanation; got nil", i+1)
+		}
+		value, ok := res.Explanation["value"].(float64)
+		if !ok {
+			t.Fatalf("case #%d: expected explanation value to be a float64; got: %T", i+1, res.Explanation["value"])
+		}
+		if want, have := tt.Value, value; want != have {
+			t.Errorf("case #%d: expected value %v; got: %v", i+1, want, have)
+		}
+	}
+}
@@ -7,6 +7,8 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"sort"
@@ -39,6 +41,14 @@ func TestFieldCapsURLs(t *testing.T) {
 			ExpectedPath:   "/index_%2A/_field_caps",
 			ExpectedParams: url.Values{"pretty": []string{"true"}},
 		},
+		{
+			Service: &FieldCapsService{
+				index:  []string{"index1"},
+				fields: []string{"rating", "title"},
+			},
+			ExpectedPath:   "/index1/_field_caps",
+			ExpectedParams: url.Values{"fields": []string{"rating,title"}},
+		},
 	}
 
 	for _, test := range tests {
@@ -173,6 +183,96 @@ func TestFieldCapsResponse(t *testing.T) {
 	}
 }
 
+func TestFieldCapsWithConflictingTypes(t *testing.T) {
+	var uri string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"indices": ["logs-1", "logs-2"],
+			"fields": {
+				"status": {
+					"long": {
+						"type": "long",
+						"searchable": true,
+						"aggregatable": true,
+						"indices": ["logs-1"]
+					},
+					"keyword": {
+						"type": "keyword",
+						"searchable": true,
+						"aggregatable": true,
+						"indices": ["logs-2"]
+					}
+				},
+				"message": {
+					"text": {
+						"type": "text",
+						"searchable": true,
+						"aggregatable": false
+					}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.FieldCaps("logs-*").Fields("status", "message").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/logs-%2A/_field_caps?fields=status%2Cmessage", uri; want != have {
+		t.Errorf("expected URL %q; got: %q", want, have)
+	}
+
+	status, ok := res.Fields["status"]
+	if !ok {
+		t.Fatalf("expected status to be in the fields map, didn't find it")
+	}
+	if want, have := 2, len(status); want != have {
+		t.Fatalf("expected status to have %d types, got %d", want, have)
+	}
+	var types []string
+	for typ, caps := range status {
+		if want, have := typ, caps.Type; want != have {
+			t.Errorf("expected status.%s.type to be %q, got %q", typ, want, have)
+		}
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	if want, have := []string{"keyword", "long"}, types; !reflect.DeepEqual(want, have) {
+		t.Errorf("expected status types to be %v, got %v", want, have)
+	}
+	if want, have := []string{"logs-1"}, status["long"].Indices; !reflect.DeepEqual(want, have) {
+		t.Errorf("expected status.long.indices to be %v, got %v", want, have)
+	}
+	if want, have := []string{"logs-2"}, status["keyword"].Indices; !reflect.DeepEqual(want, have) {
+		t.Errorf("expected status.keyword.indices to be %v, got %v", want, have)
+	}
+
+	message, ok := res.Fields["message"]
+	if !ok {
+		t.Fatalf("expected message to be in the fields map, didn't find it")
+	}
+	caps, ok := message["text"]
+	if !ok {
+		t.Fatalf("expected message.text caps to be found")
+	}
+	if want, have := true, caps.Searchable; want != have {
+		t.Errorf("expected message.text.searchable to be %v, got %v", want, have)
+	}
+	if want, have := false, caps.Aggregatable; want != have {
+		t.Errorf("expected message.text.aggregatable to be %v, got %v", want, have)
+	}
+	if want, have := 0, len(caps.Indices); want != have {
+		t.Errorf("expected message.text.indices to be empty, got %v", caps.Indices)
+	}
+}
+
 func TestFieldCapsIntegrationTest(t *testing.T) {
 	client := setupTestClientAndCreateIndexAndAddDocs(t)
 	// client := setupTestClientAndCreateIndexAndAddDocs(t, SetTraceLog(log.New(os.Stdout, "", 0)))
@@ -52,3 +52,47 @@ func GeoPointFromString(latLon string) (*GeoPoint, error) {
 	}
 	return &GeoPoint{Lat: lat, Lon: lon}, nil
 }
+
+// geoHashBase32 is the alphabet used for encoding geohashes.
+const geoHashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// GeoPointFromGeoHash initializes a new GeoPoint by a geohash, e.g. "u281z".
+// The resulting GeoPoint is the center of the cell described by the
+// geohash.
+func GeoPointFromGeoHash(geohash string) (*GeoPoint, error) {
+	if geohash == "" {
+		return nil, fmt.Errorf("elastic: %q is not a valid geohash", geohash)
+	}
+	minLat, maxLat := -90.0, 90.0
+	minLon, maxLon := -180.0, 180.0
+	even := true // geohashes start with a longitude bit
+	for _, c := range strings.ToLower(geohash) {
+		idx := strings.IndexRune(geoHashBase32, c)
+		if idx < 0 {
+			return nil, fmt.Errorf("elastic: %q is not a valid geohash", geohash)
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := idx&(1<<uint(bit)) != 0
+			if even {
+				mid := (minLon + maxLon) / 2
+				if set {
+					minLon = mid
+				} else {
+					maxLon = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if set {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return &GeoPoint{
+		Lat: (minLat + maxLat) / 2,
+		Lon: (minLon + maxLon) / 2,
+	}, nil
+}
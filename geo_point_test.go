@@ -7,6 +7,7 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -38,6 +39,71 @@ func TestGeoPointMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestGeoPointFromString(t *testing.T) {
+	tests := []struct {
+		Input   string
+		Lat     float64
+		Lon     float64
+		WantErr bool
+	}{
+		{"40.10210,-70.12091", 40.10210, -70.12091, false},
+		{"0,0", 0, 0, false},
+		{"", 0, 0, true},
+		{"40.10210", 0, 0, true},
+		{"abc,-70.12091", 0, 0, true},
+		{"40.10210,xyz", 0, 0, true},
+	}
+
+	for i, tt := range tests {
+		pt, err := GeoPointFromString(tt.Input)
+		if tt.WantErr {
+			if err == nil {
+				t.Errorf("#%d: expected error for %q", i, tt.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("#%d: expected no error for %q; got: %v", i, tt.Input, err)
+		}
+		if pt.Lat != tt.Lat || pt.Lon != tt.Lon {
+			t.Errorf("#%d: expected %v,%v; got: %v,%v", i, tt.Lat, tt.Lon, pt.Lat, pt.Lon)
+		}
+	}
+}
+
+func TestGeoPointFromGeoHash(t *testing.T) {
+	tests := []struct {
+		Input   string
+		Lat     float64
+		Lon     float64
+		WantErr bool
+	}{
+		{"u281z7j5", 48.1372, 11.5759, false},
+		{"U281Z7J5", 48.1372, 11.5759, false},
+		{"dr5regw3p", 40.7128, -74.0060, false},
+		{"s", 22.5, 22.5, false},
+		{"", 0, 0, true},
+		{"u281a", 0, 0, true}, // "a" is not part of the geohash alphabet
+		{"u28 1z", 0, 0, true},
+	}
+
+	for i, tt := range tests {
+		pt, err := GeoPointFromGeoHash(tt.Input)
+		if tt.WantErr {
+			if err == nil {
+				t.Errorf("#%d: expected error for %q", i, tt.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("#%d: expected no error for %q; got: %v", i, tt.Input, err)
+		}
+		if math.Abs(pt.Lat-tt.Lat) > 0.001 || math.Abs(pt.Lon-tt.Lon) > 0.001 {
+			t.Errorf("#%d: expected %v,%v; got: %v,%v", i, tt.Lat, tt.Lon, pt.Lat, pt.Lon)
+		}
+	}
+}
+
 func TestGeoPointIndexAndSearch(t *testing.T) {
 	client := setupTestClient(t) // , SetTraceLog(log.New(os.Stdout, "", 0)))
 
@@ -7,6 +7,8 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -141,6 +143,57 @@ func TestGetWithFields(t *testing.T) {
 	}
 }
 
+func TestGetFoundAndNotFound(t *testing.T) {
+	var uri string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/tweets/_doc/1":
+			w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"1","_version":3,"_seq_no":2,"_primary_term":1,"_routing":"olivere","found":true,"_source":{"user":"olivere"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"99","found":false}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Found
+	res, err := client.Get().Index("tweets").Id("1").Routing("olivere").Realtime(false).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets/_doc/1?realtime=false&routing=olivere", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	if !res.Found {
+		t.Errorf("expected Found = true; got: %v", res.Found)
+	}
+	if res.Version == nil || *res.Version != 3 {
+		t.Errorf("expected Version = 3; got: %v", res.Version)
+	}
+	if want, have := "olivere", res.Routing; want != have {
+		t.Errorf("expected Routing = %q; got: %q", want, have)
+	}
+	if want, have := `{"user":"olivere"}`, string(res.Source); want != have {
+		t.Errorf("expected Source = %s; got: %s", want, have)
+	}
+
+	// Not found is reported as an error, just like the error for a missing index
+	res, err = client.Get().Index("tweets").Id("99").Do(context.TODO())
+	if !IsNotFound(err) {
+		t.Fatalf("expected NotFound error; got: %v", err)
+	}
+	if res != nil {
+		t.Errorf("expected no response; got: %v", res)
+	}
+}
+
 func TestGetValidate(t *testing.T) {
 	// Mitigate against http://stackoverflow.com/questions/27491738/elasticsearch-go-index-failures-no-feature-for-name
 	client := setupTestClientAndCreateIndex(t)
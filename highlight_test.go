@@ -136,6 +136,55 @@ func TestHighlightWithBoundarySettings(t *testing.T) {
 	}
 }
 
+func TestHighlightWithTagsAndHighlighterType(t *testing.T) {
+	builder := NewHighlight().
+		Field("message").
+		PreTags("<em>").
+		PostTags("</em>").
+		FragmentSize(150).
+		NumOfFragments(3).
+		HighlighterType("unified")
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"fields":{"message":{}},"fragment_size":150,"number_of_fragments":3,"post_tags":["\u003c/em\u003e"],"pre_tags":["\u003cem\u003e"],"type":"unified"}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHighlightDecodeSearchHit(t *testing.T) {
+	js := `{
+		"_index": "tweets",
+		"_id": "1",
+		"_score": 1.0,
+		"_source": {"message": "Welcome to Golang and Elasticsearch."},
+		"highlight": {
+			"message": ["Welcome to <em>Golang</em> and Elasticsearch.", "<em>Golang</em> again"]
+		}
+	}`
+	var hit SearchHit
+	if err := json.Unmarshal([]byte(js), &hit); err != nil {
+		t.Fatal(err)
+	}
+	fragments, found := hit.Highlight["message"]
+	if !found {
+		t.Fatalf("expected highlight for field %q; got: %v", "message", hit.Highlight)
+	}
+	if want, have := 2, len(fragments); want != have {
+		t.Fatalf("expected %d fragments; got: %d", want, have)
+	}
+	if want, have := "Welcome to <em>Golang</em> and Elasticsearch.", fragments[0]; want != have {
+		t.Errorf("expected fragment %q; got: %q", want, have)
+	}
+}
+
 func TestHighlightWithTermQuery(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t) //, SetTraceLog(log.New(os.Stdout, "", 0)))
 
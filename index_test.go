@@ -7,6 +7,11 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -173,6 +178,78 @@ func TestIndexLifecycleWithAutomaticIDGeneration(t *testing.T) {
 	}
 }
 
+func TestIndexRequestWithAndWithoutID(t *testing.T) {
+	var (
+		method string
+		uri    string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		id := "1"
+		if r.Method == "POST" {
+			id = "WbL4Qm0BiO3Wq5o6aiVe"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"` + id + `","_version":1,"result":"created","_seq_no":0,"_primary_term":1}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Service *IndexService
+		Method  string
+		URI     string
+		Id      string
+	}{
+		{
+			client.Index().Index("tweets").Id("1").Routing("olivere").Refresh("true").BodyJson(map[string]string{"user": "olivere"}),
+			"PUT",
+			"/tweets/_doc/1?refresh=true&routing=olivere",
+			"1",
+		},
+		{
+			client.Index().Index("tweets").BodyString(`{"user":"olivere"}`),
+			"POST",
+			"/tweets/_doc/",
+			"WbL4Qm0BiO3Wq5o6aiVe",
+		},
+	}
+
+	for i, tt := range tests {
+		res, err := tt.Service.Do(context.TODO())
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.Method, method; want != have {
+			t.Errorf("#%d: expected method %q; got: %q", i, want, have)
+		}
+		if want, have := tt.URI, uri; want != have {
+			t.Errorf("#%d: expected URI %q; got: %q", i, want, have)
+		}
+		if want, have := `{"user":"olivere"}`, body; want != have {
+			t.Errorf("#%d: expected body %s; got: %s", i, want, have)
+		}
+		if want, have := tt.Id, res.Id; want != have {
+			t.Errorf("#%d: expected _id %q; got: %q", i, want, have)
+		}
+		if want, have := int64(1), res.Version; want != have {
+			t.Errorf("#%d: expected _version %d; got: %d", i, want, have)
+		}
+		if want, have := "created", res.Result; want != have {
+			t.Errorf("#%d: expected result %q; got: %q", i, want, have)
+		}
+	}
+}
+
 func TestIndexValidate(t *testing.T) {
 	client := setupTestClient(t)
 
@@ -316,3 +393,91 @@ func TestIndexOptimistic(t *testing.T) {
 		t.Fatalf("expected SeqNo to change (%d == %d)", want, have)
 	}
 }
+
+func TestIndexWithIfSeqNoAndIfPrimaryTerm(t *testing.T) {
+	const (
+		currentSeqNo       = 3
+		currentPrimaryTerm = 1
+	)
+
+	// URL parameters
+	_, _, params, err := NewIndexService(nil).
+		Index("tweets").Id("1").
+		IfSeqNo(currentSeqNo).
+		IfPrimaryTerm(currentPrimaryTerm).
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "3", params.Get("if_seq_no"); want != have {
+		t.Errorf("expected if_seq_no = %q; got: %q", want, have)
+	}
+	if want, have := "1", params.Get("if_primary_term"); want != have {
+		t.Errorf("expected if_primary_term = %q; got: %q", want, have)
+	}
+
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("if_seq_no") != fmt.Sprint(currentSeqNo) || query.Get("if_primary_term") != fmt.Sprint(currentPrimaryTerm) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"type":"version_conflict_engine_exception","reason":"[1]: version conflict, required seqNo [2], primary term [1]. current document has seqNo [3] and primary term [1]","index":"tweets"},"status":409}`))
+			return
+		}
+		w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"1","_version":5,"result":"updated","_seq_no":4,"_primary_term":1}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Successful conditional index
+	res, err := client.Index().
+		Index("tweets").Id("1").
+		BodyJson(map[string]interface{}{"retweets": 42}).
+		IfSeqNo(currentSeqNo).
+		IfPrimaryTerm(currentPrimaryTerm).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "3", query.Get("if_seq_no"); want != have {
+		t.Errorf("expected if_seq_no = %q; got: %q", want, have)
+	}
+	if want, have := "1", query.Get("if_primary_term"); want != have {
+		t.Errorf("expected if_primary_term = %q; got: %q", want, have)
+	}
+	if want, have := int64(4), res.SeqNo; want != have {
+		t.Errorf("expected _seq_no = %d; got: %d", want, have)
+	}
+	if want, have := int64(1), res.PrimaryTerm; want != have {
+		t.Errorf("expected _primary_term = %d; got: %d", want, have)
+	}
+
+	// Stale sequence number
+	res, err = client.Index().
+		Index("tweets").Id("1").
+		BodyJson(map[string]interface{}{"retweets": 43}).
+		IfSeqNo(currentSeqNo - 1).
+		IfPrimaryTerm(currentPrimaryTerm).
+		Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if res != nil {
+		t.Errorf("expected no response; got: %+v", res)
+	}
+	if !IsConflict(err) {
+		t.Fatalf("expected conflict error; got: %v", err)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error; got: %T", err)
+	}
+	if want, have := "version_conflict_engine_exception", e.Details.Type; want != have {
+		t.Errorf("expected error type %q; got: %q", want, have)
+	}
+}
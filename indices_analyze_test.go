@@ -2,6 +2,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -83,3 +86,92 @@ func TestIndicesAnalyzeValidate(t *testing.T) {
 		t.Fatalf("expected error %q, got %q", want, have)
 	}
 }
+
+func TestIndicesAnalyzeDecodeStandardAnalyzerTokens(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"tokens": [
+				{"token":"the","start_offset":0,"end_offset":3,"type":"<ALPHANUM>","position":0},
+				{"token":"quick","start_offset":4,"end_offset":9,"type":"<ALPHANUM>","position":1},
+				{"token":"fox","start_offset":10,"end_offset":13,"type":"<ALPHANUM>","position":2}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexAnalyze().
+		Index("tweets").
+		Analyzer("standard").
+		Text("The quick fox").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_analyze", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"text":["The quick fox"],"analyzer":"standard"}`, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	expected := []IndicesAnalyzeResponseToken{
+		{Token: "the", StartOffset: 0, EndOffset: 3, Type: "<ALPHANUM>", Position: 0},
+		{Token: "quick", StartOffset: 4, EndOffset: 9, Type: "<ALPHANUM>", Position: 1},
+		{Token: "fox", StartOffset: 10, EndOffset: 13, Type: "<ALPHANUM>", Position: 2},
+	}
+	if want, have := len(expected), len(res.Tokens); want != have {
+		t.Fatalf("expected %d tokens; got: %d", want, have)
+	}
+	for i, want := range expected {
+		if have := res.Tokens[i]; want != have {
+			t.Errorf("token #%d: expected %+v; got: %+v", i, want, have)
+		}
+	}
+}
+
+func TestIndicesAnalyzeWithTokenizerFiltersAndField(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tokens":[]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.IndexAnalyze().
+		Index("tweets").
+		Tokenizer("whitespace").
+		Filter("lowercase", "asciifolding").
+		Field("message").
+		Text("Déjà vu").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"text":["Déjà vu"],"tokenizer":"whitespace","filter":["lowercase","asciifolding"],"field":"message"}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+}
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -61,3 +64,80 @@ func TestIndicesCreateValidate(t *testing.T) {
 		t.Fatalf("expected result to be == nil; got: %v", res)
 	}
 }
+
+func TestIndicesCreateWithBodyAndAlreadyExists(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	exists := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		if exists {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"root_cause":[{"type":"resource_already_exists_exception","reason":"index [tweets/abc] already exists","index":"tweets"}],"type":"resource_already_exists_exception","reason":"index [tweets/abc] already exists","index":"tweets"},"status":400}`))
+			return
+		}
+		exists = true
+		w.Write([]byte(`{"acknowledged":true,"shards_acknowledged":true,"index":"tweets"}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mapping := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   1,
+			"number_of_replicas": 0,
+		},
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"user": map[string]interface{}{"type": "keyword"},
+			},
+		},
+	}
+	res, err := client.CreateIndex("tweets").BodyJson(mapping).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Acknowledged {
+		t.Errorf("expected acknowledged = true; got: %v", res.Acknowledged)
+	}
+	if want, have := "PUT", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"mappings":{"properties":{"user":{"type":"keyword"}}},"settings":{"number_of_replicas":0,"number_of_shards":1}}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	// Creating the index again returns a typed error
+	_, err = client.CreateIndex("tweets").BodyJson(mapping).Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error type *Error; got: %T", err)
+	}
+	if want, have := http.StatusBadRequest, e.Status; want != have {
+		t.Errorf("expected status %d; got: %d", want, have)
+	}
+	if e.Details == nil {
+		t.Fatal("expected error details")
+	}
+	if want, have := "resource_already_exists_exception", e.Details.Type; want != have {
+		t.Errorf("expected error type %q; got: %q", want, have)
+	}
+}
@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,3 +23,60 @@ func TestIndicesDeleteValidate(t *testing.T) {
 		t.Fatalf("expected result to be == nil; got: %v", res)
 	}
 }
+
+func TestIndicesDeleteBuildURL(t *testing.T) {
+	tests := []struct {
+		Service  *IndicesDeleteService
+		Path     string
+		Expected string
+	}{
+		{
+			NewIndicesDeleteService(nil).Index([]string{"logs-*"}),
+			"/logs-%2A",
+			"",
+		},
+		{
+			NewIndicesDeleteService(nil).Index([]string{"tweets", "comments"}).IgnoreUnavailable(true).AllowNoIndices(true).ExpandWildcards("open"),
+			"/tweets%2Ccomments",
+			"allow_no_indices=true&expand_wildcards=open&ignore_unavailable=true",
+		},
+	}
+
+	for i, tt := range tests {
+		path, params, err := tt.Service.buildURL()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.Path, path; want != have {
+			t.Errorf("#%d: expected path %q; got: %q", i, want, have)
+		}
+		if want, have := tt.Expected, params.Encode(); want != have {
+			t.Errorf("#%d: expected query string %q; got: %q", i, want, have)
+		}
+	}
+}
+
+func TestIndicesDeleteIgnoreUnavailable(t *testing.T) {
+	var uri string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.DeleteIndex("no-such-index").IgnoreUnavailable(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Acknowledged {
+		t.Errorf("expected acknowledged = true; got: %v", res.Acknowledged)
+	}
+	if want, have := "/no-such-index?ignore_unavailable=true", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+}
@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,3 +23,45 @@ func TestIndicesExistsWithoutIndex(t *testing.T) {
 		t.Fatalf("expected result to be false; got: %v", res)
 	}
 }
+
+func TestIndicesExistsStatusCodes(t *testing.T) {
+	tests := []struct {
+		StatusCode int
+		Exists     bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusNotFound, false},
+	}
+
+	for i, tt := range tests {
+		var (
+			method string
+			path   string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			path = r.URL.Path
+			w.WriteHeader(tt.StatusCode)
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		exists, err := client.IndexExists("tweets").Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.Exists, exists; want != have {
+			t.Errorf("#%d: expected exists = %v; got: %v", i, want, have)
+		}
+		if want, have := "HEAD", method; want != have {
+			t.Errorf("#%d: expected method %q; got: %q", i, want, have)
+		}
+		if want, have := "/tweets", path; want != have {
+			t.Errorf("#%d: expected path %q; got: %q", i, want, have)
+		}
+	}
+}
@@ -68,3 +68,23 @@ func TestFlushBuildURL(t *testing.T) {
 		}
 	}
 }
+
+func TestFlushBuildURLWithOptions(t *testing.T) {
+	path, params, err := NewIndicesFlushService(nil).
+		Index("tweets").
+		WaitIfOngoing(true).
+		Force(false).
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets/_flush", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "true", params.Get("wait_if_ongoing"); want != have {
+		t.Errorf("expected wait_if_ongoing = %q; got: %q", want, have)
+	}
+	if want, have := "false", params.Get("force"); want != have {
+		t.Errorf("expected force = %q; got: %q", want, have)
+	}
+}
@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -48,3 +51,40 @@ func TestIndicesGetMappingURL(t *testing.T) {
 		}
 	}
 }
+
+func TestIndicesGetMappingWithMultipleIndices(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"tweets": {"mappings": {"properties": {"user": {"type": "keyword"}}}},
+			"comments": {"mappings": {"properties": {"text": {"type": "text"}}}}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.GetMapping().Index("tweets", "comments").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets,comments/_mapping/_all", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := 2, len(res); want != have {
+		t.Fatalf("expected mappings for %d indices; got: %d", want, have)
+	}
+	for _, index := range []string{"tweets", "comments"} {
+		m, ok := res[index].(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected mapping for index %q; got: %v", index, res[index])
+		}
+		if _, ok := m["mappings"]; !ok {
+			t.Errorf("expected mappings for index %q; got: %v", index, m)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -80,3 +82,50 @@ func TestIndexGetSettingsService(t *testing.T) {
 		t.Fatalf("expected index settings of %q to be != nil; got: %v", testIndexName, info.Settings)
 	}
 }
+
+func TestIndexGetSettingsDecodeResponse(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"tweets": {
+				"settings": {
+					"index": {
+						"number_of_shards": "1",
+						"number_of_replicas": "2",
+						"refresh_interval": "30s",
+						"provided_name": "tweets"
+					}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexGetSettings("tweets").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets/_settings", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	info, found := res["tweets"]
+	if !found {
+		t.Fatalf("expected settings for index %q", "tweets")
+	}
+	index, ok := info.Settings["index"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected index settings to be a map; got: %T", info.Settings["index"])
+	}
+	if want, have := "2", index["number_of_replicas"]; want != have {
+		t.Errorf("expected number_of_replicas = %q; got: %v", want, have)
+	}
+	if want, have := "30s", index["refresh_interval"]; want != have {
+		t.Errorf("expected refresh_interval = %q; got: %v", want, have)
+	}
+}
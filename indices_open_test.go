@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,3 +23,41 @@ func TestIndicesOpenValidate(t *testing.T) {
 		t.Fatalf("expected result to be == nil; got: %v", res)
 	}
 }
+
+func TestIndicesOpenBuildURLAndDecodeResponse(t *testing.T) {
+	var (
+		method string
+		uri    string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		uri = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true,"shards_acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.OpenIndex("tweets").IgnoreUnavailable(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_open?ignore_unavailable=true", uri; want != have {
+		t.Errorf("expected URL %q; got: %q", want, have)
+	}
+	if res == nil {
+		t.Fatal("expected response; got nil")
+	}
+	if !res.Acknowledged {
+		t.Error("expected Acknowledged = true")
+	}
+	if !res.ShardsAcknowledged {
+		t.Error("expected ShardsAcknowledged = true")
+	}
+}
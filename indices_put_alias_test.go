@@ -7,6 +7,9 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -145,6 +148,49 @@ func TestAliasLifecycle(t *testing.T) {
 	}
 }
 
+func TestAliasSwapInOneRequest(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Alias().
+		Remove("tweets-v1", "tweets").
+		Add("tweets-v2", "tweets").
+		AddWithFilter("tweets-v2", "tweets-olivere", NewTermQuery("user", "olivere")).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Acknowledged {
+		t.Errorf("expected acknowledged = true; got: %v", res.Acknowledged)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/_aliases", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"actions":[{"remove":{"alias":"tweets","index":"tweets-v1"}},{"add":{"alias":"tweets","index":"tweets-v2"}},{"add":{"alias":"tweets-olivere","filter":{"term":{"user":"olivere"}},"index":"tweets-v2"}}]}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+}
+
 func TestAliasAddAction(t *testing.T) {
 	var tests = []struct {
 		Action   *AliasAddAction
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -83,3 +86,48 @@ func TestMappingLifecycle(t *testing.T) {
 
 	// NOTE There is no Delete Mapping API in Elasticsearch 2.0
 }
+
+func TestPutMappingBody(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapping := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"message": map[string]interface{}{"type": "text"},
+			"user":    map[string]interface{}{"type": "keyword"},
+		},
+	}
+	res, err := client.PutMapping().Index("tweets").BodyJson(mapping).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Acknowledged {
+		t.Errorf("expected acknowledged = true; got: %v", res.Acknowledged)
+	}
+	if want, have := "PUT", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_mapping", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"properties":{"message":{"type":"text"},"user":{"type":"keyword"}}}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+}
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -93,3 +96,48 @@ func TestIndicesSettingsLifecycle(t *testing.T) {
 		t.Fatalf("expected refresh_interval = %v; got: %v", want, got)
 	}
 }
+
+func TestIndicesPutSettingsReplicasAndRefreshInterval(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexPutSettings("tweets").
+		BodyJson(map[string]interface{}{
+			"index": map[string]interface{}{
+				"number_of_replicas": 0,
+				"refresh_interval":   "-1",
+			},
+		}).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "PUT", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_settings", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"index":{"number_of_replicas":0,"refresh_interval":"-1"}}`, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if !res.Acknowledged {
+		t.Error("expected Acknowledged = true")
+	}
+}
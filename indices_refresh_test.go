@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -79,3 +81,44 @@ func TestRefresh(t *testing.T) {
 		t.Fatal("expected result; got nil")
 	}
 }
+
+func TestRefreshDecodeShards(t *testing.T) {
+	var (
+		method string
+		path   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"_shards":{"total":10,"successful":5,"failed":0}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Refresh("tweets", "comments").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets,comments/_refresh", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if res.Shards == nil {
+		t.Fatal("expected shards info; got nil")
+	}
+	if want, have := 10, res.Shards.Total; want != have {
+		t.Errorf("expected Shards.Total = %d; got: %d", want, have)
+	}
+	if want, have := 5, res.Shards.Successful; want != have {
+		t.Errorf("expected Shards.Successful = %d; got: %d", want, have)
+	}
+	if want, have := 0, res.Shards.Failed; want != have {
+		t.Errorf("expected Shards.Failed = %d; got: %d", want, have)
+	}
+}
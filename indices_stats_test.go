@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -122,3 +124,68 @@ func TestIndexStatsWithShards(t *testing.T) {
 		t.Fatalf("expected docs to be != nil; got: %v", shard[0].Docs)
 	}
 }
+
+func TestIndexStatsDecodeDocsAndStore(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"_shards": {"total": 10, "successful": 5, "failed": 0},
+			"_all": {
+				"primaries": {"docs": {"count": 1500, "deleted": 12}, "store": {"size_in_bytes": 1048576}},
+				"total": {"docs": {"count": 3000, "deleted": 24}, "store": {"size_in_bytes": 2097152}}
+			},
+			"indices": {
+				"tweets": {
+					"uuid": "8bOt9BbbRs6k1zwMzB2RcQ",
+					"primaries": {"docs": {"count": 1000, "deleted": 10}, "store": {"size_in_bytes": 786432}},
+					"total": {"docs": {"count": 2000, "deleted": 20}, "store": {"size_in_bytes": 1572864}}
+				},
+				"comments": {
+					"uuid": "Q8r0aLmVQ1aUiMb7dbT6Hg",
+					"primaries": {"docs": {"count": 500, "deleted": 2}, "store": {"size_in_bytes": 262144}},
+					"total": {"docs": {"count": 1000, "deleted": 4}, "store": {"size_in_bytes": 524288}}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexStats("tweets", "comments").Metric("docs", "store").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets,comments/_stats/docs,store", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if res.All == nil || res.All.Primaries == nil || res.All.Total == nil {
+		t.Fatalf("expected _all primaries and total; got: %+v", res.All)
+	}
+	if want, have := int64(1500), res.All.Primaries.Docs.Count; want != have {
+		t.Errorf("expected _all.primaries.docs.count = %d; got: %d", want, have)
+	}
+	if want, have := int64(2097152), res.All.Total.Store.SizeInBytes; want != have {
+		t.Errorf("expected _all.total.store.size_in_bytes = %d; got: %d", want, have)
+	}
+	if want, have := 2, len(res.Indices); want != have {
+		t.Fatalf("expected %d indices; got: %d", want, have)
+	}
+	stats, found := res.Indices["tweets"]
+	if !found {
+		t.Fatalf("expected stats for index %q", "tweets")
+	}
+	if want, have := int64(1000), stats.Primaries.Docs.Count; want != have {
+		t.Errorf("expected tweets.primaries.docs.count = %d; got: %d", want, have)
+	}
+	if want, have := int64(10), stats.Primaries.Docs.Deleted; want != have {
+		t.Errorf("expected tweets.primaries.docs.deleted = %d; got: %d", want, have)
+	}
+	if want, have := int64(1572864), stats.Total.Store.SizeInBytes; want != have {
+		t.Errorf("expected tweets.total.store.size_in_bytes = %d; got: %d", want, have)
+	}
+}
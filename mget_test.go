@@ -7,6 +7,9 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -94,3 +97,58 @@ func TestMultiGet(t *testing.T) {
 		t.Errorf("expected Message of second tweet to be %q; got %q", tweet3.Message, doc.Message)
 	}
 }
+
+func TestMultiGetPreservesOrderWithMissingDocs(t *testing.T) {
+	var (
+		uri  string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"docs":[
+			{"_index":"tweets","_type":"_doc","_id":"1","_version":1,"found":true,"_source":{"user":"olivere"}},
+			{"_index":"tweets","_type":"_doc","_id":"2","found":false},
+			{"_index":"tweets","_type":"_doc","_id":"3","_version":2,"found":true,"_source":{"user":"sandrae"}}
+		]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.MultiGet().
+		Add(NewMultiGetItem().Index("tweets").Id("1")).
+		Add(NewMultiGetItem().Index("tweets").Id("2")).
+		Add(NewMultiGetItem().Index("tweets").Id("3").FetchSource(NewFetchSourceContext(true).Include("user"))).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := "/_mget", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	expected := `{"docs":[{"_id":"1","_index":"tweets"},{"_id":"2","_index":"tweets"},{"_id":"3","_index":"tweets","_source":{"includes":["user"]}}]}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	if want, have := 3, len(res.Docs); want != have {
+		t.Fatalf("expected %d docs; got: %d", want, have)
+	}
+	for i, id := range []string{"1", "2", "3"} {
+		if want, have := id, res.Docs[i].Id; want != have {
+			t.Errorf("doc #%d: expected _id %q; got: %q", i, want, have)
+		}
+	}
+	if !res.Docs[0].Found || res.Docs[1].Found || !res.Docs[2].Found {
+		t.Errorf("expected found flags true,false,true; got: %v,%v,%v", res.Docs[0].Found, res.Docs[1].Found, res.Docs[2].Found)
+	}
+	if res.Docs[1].Source != nil {
+		t.Errorf("expected no source for missing doc; got: %s", res.Docs[1].Source)
+	}
+}
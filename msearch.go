@@ -93,10 +93,11 @@ func (s *MultiSearchService) Do(ctx context.Context) (*MultiSearchResult, error)
 
 	// Get response
 	res, err := s.client.PerformRequest(ctx, PerformRequestOptions{
-		Method: "GET",
-		Path:   path,
-		Params: params,
-		Body:   body,
+		Method:      "GET",
+		Path:        path,
+		Params:      params,
+		Body:        body,
+		ContentType: "application/x-ndjson",
 	})
 	if err != nil {
 		return nil, err
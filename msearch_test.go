@@ -7,7 +7,9 @@ package elastic
 import (
 	"context"
 	"encoding/json"
-	_ "net/http"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -301,3 +303,66 @@ func TestMultiSearchWithOneRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestMultiSearchBodyAndResponseOrder(t *testing.T) {
+	var (
+		uri         string
+		contentType string
+		body        string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		contentType = r.Header.Get("Content-Type")
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"responses":[
+			{"took":1,"hits":{"total":{"value":3,"relation":"eq"},"hits":[{"_id":"1"},{"_id":"2"},{"_id":"3"}]},"status":200},
+			{"took":2,"hits":{"total":{"value":1,"relation":"eq"},"hits":[{"_id":"2"}]},"status":200}
+		]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sreq1 := NewSearchRequest().Index("tweets", "comments").
+		Source(NewSearchSource().Query(NewMatchAllQuery()).Size(10))
+	sreq2 := NewSearchRequest().Index("tweets").
+		Source(NewSearchSource().Query(NewTermQuery("tags", "golang")))
+
+	res, err := client.MultiSearch().Add(sreq1, sreq2).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := "/_msearch", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	if want, have := "application/x-ndjson", contentType; want != have {
+		t.Errorf("expected Content-Type %q; got: %q", want, have)
+	}
+	expected := `{"indices":["tweets","comments"]}
+{"query":{"match_all":{}},"size":10}
+{"index":"tweets"}
+{"query":{"term":{"tags":"golang"}}}
+`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	if want, have := 2, len(res.Responses); want != have {
+		t.Fatalf("expected %d responses; got: %d", want, have)
+	}
+	if want, have := int64(3), res.Responses[0].TotalHits(); want != have {
+		t.Errorf("expected %d hits in response #1; got: %d", want, have)
+	}
+	if want, have := int64(1), res.Responses[1].TotalHits(); want != have {
+		t.Errorf("expected %d hits in response #2; got: %d", want, have)
+	}
+	if want, have := "2", res.Responses[1].Hits.Hits[0].Id; want != have {
+		t.Errorf("expected _id %q in response #2; got: %q", want, have)
+	}
+}
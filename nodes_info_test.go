@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -86,3 +88,76 @@ func TestNodesInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestNodesInfoDecodeTwoNodes(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"_nodes": {"total": 2, "successful": 2, "failed": 0},
+			"cluster_name": "elasticsearch",
+			"nodes": {
+				"n1": {
+					"name": "node-1",
+					"version": "7.4.0",
+					"roles": ["master", "data", "ingest"],
+					"os": {"name": "Linux", "arch": "amd64", "available_processors": 4},
+					"jvm": {"pid": 1, "version": "13", "vm_name": "OpenJDK 64-Bit Server VM", "mem": {"heap_max_in_bytes": 1056309248}}
+				},
+				"n2": {
+					"name": "node-2",
+					"version": "7.4.0",
+					"roles": ["data"],
+					"os": {"name": "Linux", "arch": "amd64", "available_processors": 8},
+					"jvm": {"pid": 2, "version": "13", "vm_name": "OpenJDK 64-Bit Server VM", "mem": {"heap_max_in_bytes": 2112618496}}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.NodesInfo().Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_nodes/_all/_all", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "elasticsearch", res.ClusterName; want != have {
+		t.Errorf("expected cluster name %q; got: %q", want, have)
+	}
+	if want, have := 2, len(res.Nodes); want != have {
+		t.Fatalf("expected %d nodes; got: %d", want, have)
+	}
+
+	n1, n2 := res.Nodes["n1"], res.Nodes["n2"]
+	if n1 == nil || n2 == nil {
+		t.Fatalf("expected nodes n1 and n2; got: %v", res.Nodes)
+	}
+	if want, have := "7.4.0", n1.Version; want != have {
+		t.Errorf("expected version %q; got: %q", want, have)
+	}
+	if !n1.IsMaster() || !n1.IsData() || !n1.IsIngest() {
+		t.Errorf("expected n1 to be master, data and ingest node; got roles %v", n1.Roles)
+	}
+	if n2.IsMaster() || !n2.IsData() {
+		t.Errorf("expected n2 to be a data-only node; got roles %v", n2.Roles)
+	}
+	if n2.OS == nil {
+		t.Fatal("expected OS info; got nil")
+	}
+	if want, have := 8, n2.OS.AvailableProcessors; want != have {
+		t.Errorf("expected %d available processors; got: %d", want, have)
+	}
+	if n1.JVM == nil {
+		t.Fatal("expected JVM info; got nil")
+	}
+	if want, have := 1056309248, n1.JVM.Mem.HeapMaxInBytes; want != have {
+		t.Errorf("expected heap max of %d bytes; got: %d", want, have)
+	}
+}
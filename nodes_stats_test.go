@@ -6,6 +6,8 @@ package elastic
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -136,3 +138,83 @@ func TestNodesStatsBuildURL(t *testing.T) {
 		}
 	}
 }
+
+func TestNodesStatsDecodeSingleNode(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"_nodes": {"total": 1, "successful": 1, "failed": 0},
+			"cluster_name": "elasticsearch",
+			"nodes": {
+				"n1": {
+					"timestamp": 1570000000000,
+					"name": "node-1",
+					"roles": ["master", "data", "ingest"],
+					"jvm": {
+						"timestamp": 1570000000000,
+						"uptime_in_millis": 3600000,
+						"mem": {"heap_used_in_bytes": 268435456, "heap_used_percent": 25, "heap_max_in_bytes": 1073741824}
+					},
+					"thread_pool": {
+						"search": {"threads": 7, "queue": 0, "active": 1, "rejected": 3, "largest": 7, "completed": 4711},
+						"write": {"threads": 4, "queue": 2, "active": 4, "rejected": 0, "largest": 4, "completed": 815}
+					},
+					"fs": {
+						"timestamp": 1570000000000,
+						"total": {"total_in_bytes": 107374182400, "free_in_bytes": 53687091200, "available_in_bytes": 48318382080}
+					}
+				}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.NodesStats().Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_nodes/stats", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := 1, len(res.Nodes); want != have {
+		t.Fatalf("expected %d nodes; got: %d", want, have)
+	}
+	node := res.Nodes["n1"]
+	if node == nil {
+		t.Fatalf("expected node n1; got: %v", res.Nodes)
+	}
+	if node.JVM == nil || node.JVM.Mem == nil {
+		t.Fatal("expected JVM memory stats; got nil")
+	}
+	if want, have := int64(268435456), node.JVM.Mem.HeapUsedInBytes; want != have {
+		t.Errorf("expected heap used of %d bytes; got: %d", want, have)
+	}
+	if want, have := 25, node.JVM.Mem.HeapUsedPercent; want != have {
+		t.Errorf("expected heap used percent of %d; got: %d", want, have)
+	}
+	search, found := node.ThreadPool["search"]
+	if !found {
+		t.Fatalf("expected thread pool %q", "search")
+	}
+	if want, have := int64(3), search.Rejected; want != have {
+		t.Errorf("expected %d rejected search tasks; got: %d", want, have)
+	}
+	if want, have := int64(4711), search.Completed; want != have {
+		t.Errorf("expected %d completed search tasks; got: %d", want, have)
+	}
+	if want, have := 2, node.ThreadPool["write"].Queue; want != have {
+		t.Errorf("expected write queue of %d; got: %d", want, have)
+	}
+	if node.FS == nil || node.FS.Total == nil {
+		t.Fatal("expected FS stats; got nil")
+	}
+	if want, have := int64(48318382080), node.FS.Total.AvailableInBytes; want != have {
+		t.Errorf("expected %d available bytes; got: %d", want, have)
+	}
+}
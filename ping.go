@@ -7,8 +7,11 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // PingService checks if an Elasticsearch server on a given URL is alive.
@@ -43,6 +46,31 @@ type PingResult struct {
 	TagLine string `json:"tagline"`
 }
 
+// CheckMinimumVersion returns an *UnsupportedVersionError if the major
+// version of the Elasticsearch server is lower than minMajor.
+func (r *PingResult) CheckMinimumVersion(minMajor int) error {
+	major, err := strconv.Atoi(strings.SplitN(r.Version.Number, ".", 2)[0])
+	if err != nil {
+		return fmt.Errorf("elastic: unable to parse Elasticsearch version %q", r.Version.Number)
+	}
+	if major < minMajor {
+		return &UnsupportedVersionError{Version: r.Version.Number, MinimumMajor: minMajor}
+	}
+	return nil
+}
+
+// UnsupportedVersionError is returned by PingResult.CheckMinimumVersion
+// when the Elasticsearch server is too old.
+type UnsupportedVersionError struct {
+	Version      string // version reported by the server, e.g. "6.8.3"
+	MinimumMajor int    // minimum major version required, e.g. 7
+}
+
+// Error returns a string representation of the error.
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("elastic: Elasticsearch %s is not supported, need at least %d.x", e.Version, e.MinimumMajor)
+}
+
 func NewPingService(client *Client) *PingService {
 	return &PingService{
 		client:       client,
@@ -7,6 +7,7 @@ package elastic
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -63,3 +64,67 @@ func TestPingHeadFailure(t *testing.T) {
 		t.Errorf("expected not to return result, got: %v", res)
 	}
 }
+
+func TestPingDecodeRootInfo(t *testing.T) {
+	var method string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"name": "node-1",
+			"cluster_name": "elasticsearch",
+			"cluster_uuid": "gCmPqYuVTsWBI9GV5H1zPg",
+			"version": {
+				"number": "7.4.0",
+				"build_flavor": "default",
+				"build_type": "docker",
+				"lucene_version": "8.2.0",
+				"minimum_wire_compatibility_version": "6.8.0",
+				"minimum_index_compatibility_version": "6.0.0-beta1"
+			},
+			"tagline": "You Know, for Search"
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, code, err := client.Ping(ts.URL).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "GET", method; want != have {
+		t.Errorf("expected method %q; got %q", want, have)
+	}
+	if want, have := http.StatusOK, code; want != have {
+		t.Errorf("expected status code = %d; got %d", want, have)
+	}
+	if want, have := "node-1", res.Name; want != have {
+		t.Errorf("expected Name = %q; got %q", want, have)
+	}
+	if want, have := "elasticsearch", res.ClusterName; want != have {
+		t.Errorf("expected ClusterName = %q; got %q", want, have)
+	}
+	if want, have := "7.4.0", res.Version.Number; want != have {
+		t.Errorf("expected Version.Number = %q; got %q", want, have)
+	}
+	if err := res.CheckMinimumVersion(7); err != nil {
+		t.Errorf("expected version 7.4.0 to be supported; got: %v", err)
+	}
+	err = res.CheckMinimumVersion(8)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	verr, ok := err.(*UnsupportedVersionError)
+	if !ok {
+		t.Fatalf("expected *UnsupportedVersionError; got: %T", err)
+	}
+	if want, have := "7.4.0", verr.Version; want != have {
+		t.Errorf("expected Version = %q; got %q", want, have)
+	}
+	if want, have := 8, verr.MinimumMajor; want != have {
+		t.Errorf("expected MinimumMajor = %d; got %d", want, have)
+	}
+}
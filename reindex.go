@@ -83,7 +83,8 @@ func (s *ReindexService) Timeout(timeout string) *ReindexService {
 }
 
 // WaitForCompletion indicates whether Elasticsearch should block until the
-// reindex is complete.
+// reindex is complete. If set to false, Do returns immediately and only
+// TaskId is populated in the response. See also DoAsync.
 func (s *ReindexService) WaitForCompletion(waitForCompletion bool) *ReindexService {
 	s.waitForCompletion = &waitForCompletion
 	return s
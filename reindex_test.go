@@ -7,6 +7,9 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -254,7 +257,7 @@ func TestReindexSourceWithRouting(t *testing.T) {
 		t.Fatal(err)
 	}
 	got := string(b)
-	want := `{"dest":{"index":"dest","routing":"=cat"},"source":{"index":"source","query":{"match":{"company":{"query":"cat"}}}}}`
+	want := `{"dest":{"index":"dest","routing":"=cat"},"source":{"index":"source","query":{"match":{"company":"cat"}}}}`
 	if got != want {
 		t.Fatalf("\ngot  %s\nwant %s", got, want)
 	}
@@ -421,3 +424,88 @@ func TestReindexWithWaitForCompletionTrueCannotBeStarted(t *testing.T) {
 		t.Fatal("error should have been returned")
 	}
 }
+
+func TestReindexDoSyncAndAsyncResponses(t *testing.T) {
+	var (
+		query string
+		body  string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "/_reindex", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		query = r.URL.RawQuery
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("wait_for_completion") == "false" {
+			w.Write([]byte(`{"task":"oTUltX4IQMOUUVeiohTt8A:12345"}`))
+			return
+		}
+		w.Write([]byte(`{"took":147,"timed_out":false,"total":120,"updated":0,"created":120,"deleted":0,"batches":1,"version_conflicts":2,"noops":0,"failures":[]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Synchronous
+	src := NewReindexSource().Index("tweets-v1").Query(NewTermQuery("user", "olivere"))
+	res, err := client.Reindex().
+		Source(src).
+		DestinationIndex("tweets-v2").
+		Script(NewScript("ctx._source.migrated = true")).
+		Conflicts("proceed").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"conflicts":"proceed","dest":{"index":"tweets-v2"},"script":{"source":"ctx._source.migrated = true"},"source":{"index":"tweets-v1","query":{"term":{"user":"olivere"}}}}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if want, have := "", query; want != have {
+		t.Errorf("expected query string %q; got: %q", want, have)
+	}
+	if want, have := int64(120), res.Created; want != have {
+		t.Errorf("expected Created = %d; got: %d", want, have)
+	}
+	if want, have := int64(2), res.VersionConflicts; want != have {
+		t.Errorf("expected VersionConflicts = %d; got: %d", want, have)
+	}
+	if want, have := "", res.TaskId; want != have {
+		t.Errorf("expected TaskId = %q; got: %q", want, have)
+	}
+
+	// Asynchronous
+	res, err = client.Reindex().
+		SourceIndex("tweets-v1").
+		DestinationIndex("tweets-v2").
+		WaitForCompletion(false).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "wait_for_completion=false", query; want != have {
+		t.Errorf("expected query string %q; got: %q", want, have)
+	}
+	if want, have := "oTUltX4IQMOUUVeiohTt8A:12345", res.TaskId; want != have {
+		t.Errorf("expected TaskId = %q; got: %q", want, have)
+	}
+	if want, have := int64(0), res.Created; want != have {
+		t.Errorf("expected Created = %d; got: %d", want, have)
+	}
+
+	task, err := client.Reindex().
+		SourceIndex("tweets-v1").
+		DestinationIndex("tweets-v2").
+		DoAsync(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "oTUltX4IQMOUUVeiohTt8A:12345", task.TaskId; want != have {
+		t.Errorf("expected TaskId = %q; got: %q", want, have)
+	}
+}
@@ -5,11 +5,13 @@
 package elastic
 
 import (
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 var (
@@ -35,11 +37,18 @@ func (c *Client) newResponse(res *http.Response, maxBodySize int64) (*Response,
 		Header:     res.Header,
 	}
 	if res.Body != nil {
-		body := io.Reader(res.Body)
 		if maxBodySize > 0 {
 			if res.ContentLength > maxBodySize {
 				return nil, ErrResponseSize
 			}
+		}
+		rc, err := responseBody(res)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		body := io.Reader(rc)
+		if maxBodySize > 0 {
 			body = io.LimitReader(body, maxBodySize+1)
 		}
 		slurp, err := ioutil.ReadAll(body)
@@ -56,3 +65,22 @@ func (c *Client) newResponse(res *http.Response, maxBodySize int64) (*Response,
 	}
 	return r, nil
 }
+
+// responseBody returns the body of the HTTP response, decompressing it
+// if necessary. The HTTP transport only decompresses transparently if it
+// asked for compression itself. If e.g. "Accept-Encoding: gzip" was set
+// explicitly, we need to decompress the body ourselves.
+func responseBody(res *http.Response) (io.ReadCloser, error) {
+	if res.Uncompressed || !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return ioutil.NopCloser(res.Body), nil
+	}
+	gz, err := gzip.NewReader(res.Body)
+	if err == io.EOF {
+		// Empty body, e.g. for HEAD requests or 204 No Content
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gz, nil
+}
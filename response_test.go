@@ -6,9 +6,13 @@ package elastic
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -46,3 +50,127 @@ func BenchmarkResponse(b *testing.B) {
 	}
 	_ = resp
 }
+
+func TestResponseWithGzipContentEncoding(t *testing.T) {
+	c := &Client{
+		decoder: &DefaultDecoder{},
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(`{"n":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Header http.Header
+		Body   []byte
+	}{
+		{
+			Header: http.Header{"Content-Encoding": []string{"gzip"}},
+			Body:   buf.Bytes(),
+		},
+		{
+			Header: http.Header{},
+			Body:   []byte(`{"n":1}`),
+		},
+	}
+
+	for i, tt := range tests {
+		res := &http.Response{
+			Header:     tt.Header,
+			Body:       ioutil.NopCloser(bytes.NewReader(tt.Body)),
+			StatusCode: http.StatusOK,
+		}
+		resp, err := c.newResponse(res, 0)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := `{"n":1}`, string(resp.Body); want != have {
+			t.Fatalf("#%d: want %q, have %q", i, want, have)
+		}
+	}
+}
+
+func TestResponseWithGzipContentEncodingAndEmptyBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		if r.Method == "HEAD" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		Method     string
+		StatusCode int
+	}{
+		{"HEAD", http.StatusOK},
+		{"DELETE", http.StatusNoContent},
+	}
+	for i, tt := range tests {
+		res, err := client.PerformRequest(context.Background(), PerformRequestOptions{
+			Method:  tt.Method,
+			Path:    "/tweets",
+			Headers: http.Header{"Accept-Encoding": []string{"gzip"}},
+		})
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.StatusCode, res.StatusCode; want != have {
+			t.Errorf("#%d: want status %d, have %d", i, want, have)
+		}
+		if len(res.Body) != 0 {
+			t.Errorf("#%d: want empty body, have %q", i, string(res.Body))
+		}
+	}
+}
+
+func TestResponseWithGzipFromServer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"took":1,"hits":{"total":{"value":0,"relation":"eq"},"hits":[]},"suggest":{"song-suggest":[{"text":"nir","offset":0,"length":3,"options":[{"text":"Nirvana","_id":"1","_score":1.0}]}]}}`))
+		gz.Close()
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.PerformRequest(context.Background(), PerformRequestOptions{
+		Method:  "POST",
+		Path:    "/songs/_search",
+		Body:    `{"suggest":{"song-suggest":{"prefix":"nir","completion":{"field":"suggest"}}}}`,
+		Headers: http.Header{"Accept-Encoding": []string{"gzip"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret := new(SearchResult)
+	if err := json.Unmarshal(res.Body, ret); err != nil {
+		t.Fatal(err)
+	}
+	suggestions := ret.Suggest["song-suggest"]
+	if want, have := 1, len(suggestions); want != have {
+		t.Fatalf("want %d suggestions, have %d", want, have)
+	}
+	if want, have := "Nirvana", suggestions[0].Options[0].Text; want != have {
+		t.Fatalf("want %q, have %q", want, have)
+	}
+}
@@ -30,6 +30,12 @@ type Retrier interface {
 	// Callers may also use this to inspect the HTTP request/response and
 	// the error that happened. Additional data can be passed through via
 	// the context.
+	//
+	// Notice that err may be nil: When the response carries one of the
+	// status codes configured via SetRetryStatusCodes or
+	// PerformRequestOptions.RetryStatusCodes, Retry is called with the
+	// request and response, but without an error. Implementations must
+	// not assume that err is non-nil.
 	Retry(ctx context.Context, retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool, error)
 }
 
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dhan-yext/elastic/uritemplates"
 )
@@ -19,6 +20,10 @@ import (
 const (
 	// DefaultScrollKeepAlive is the default time a scroll cursor will be kept alive.
 	DefaultScrollKeepAlive = "5m"
+
+	// scrollClearTimeout is the time Each waits for the scroll cursor
+	// to be cleared.
+	scrollClearTimeout = 5 * time.Second
 )
 
 // ScrollService iterates over pages of search results from Elasticsearch.
@@ -317,6 +322,37 @@ func (s *ScrollService) Clear(ctx context.Context) error {
 	return nil
 }
 
+// Each fetches successive pages of search results and invokes fn with the
+// hits of each page until there are no more results. Iteration stops early
+// when fn returns an error or ctx is done. In any case, Each tries to
+// clear the scroll before it returns, waiting at most a few seconds.
+func (s *ScrollService) Each(ctx context.Context, fn func([]*SearchHit) error) (err error) {
+	defer func() {
+		// Use a new context: ctx might already be canceled
+		cctx, cancel := context.WithTimeout(context.Background(), scrollClearTimeout)
+		defer cancel()
+		if cerr := s.Clear(cctx); err == nil && cerr != nil && !IsNotFound(cerr) {
+			err = cerr
+		}
+	}()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		res, err := s.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(res.Hits.Hits); err != nil {
+			return err
+		}
+	}
+}
+
 // -- First --
 
 // first takes the first page of search results.
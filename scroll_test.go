@@ -7,8 +7,12 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
-	_ "net/http"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -619,3 +623,181 @@ func TestScrollWithFilterPathKeepingContext(t *testing.T) {
 		t.Fatal("expected to fail")
 	}
 }
+
+func TestScrollWalksBatchesAndClears(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+strings.TrimSpace(string(body)))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/tweets/_search":
+			w.Write([]byte(`{"_scroll_id":"c2Nyb2xs","hits":{"total":{"value":2,"relation":"eq"},"hits":[{"_id":"1","_source":{}},{"_id":"2","_source":{}}]}}`))
+		case r.Method == "POST" && r.URL.Path == "/_search/scroll":
+			w.Write([]byte(`{"_scroll_id":"c2Nyb2xs","hits":{"total":{"value":2,"relation":"eq"},"hits":[]}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/_search/scroll":
+			w.Write([]byte(`{"succeeded":true,"num_freed":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := client.Scroll("tweets").Query(NewMatchAllQuery()).Size(2).Scroll("1m")
+
+	// First batch
+	res, err := svc.Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "c2Nyb2xs", res.ScrollId; want != have {
+		t.Fatalf("expected scroll id %q; got: %q", want, have)
+	}
+	if want, have := 2, len(res.Hits.Hits); want != have {
+		t.Fatalf("expected %d hits; got: %d", want, have)
+	}
+
+	// Second batch is empty
+	res, err = svc.Do(context.TODO())
+	if err != io.EOF {
+		t.Fatalf("expected io.EOF; got: %v", err)
+	}
+	if res == nil {
+		t.Fatal("expected results != nil; got nil")
+	}
+
+	// Release the scroll context
+	if err := svc.Clear(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`POST /tweets/_search?scroll=1m&size=2 {"query":{"match_all":{}},"sort":["_doc"]}`,
+		`POST /_search/scroll {"scroll":"1m","scroll_id":"c2Nyb2xs"}`,
+		`DELETE /_search/scroll {"scroll_id":["c2Nyb2xs"]}`,
+	}
+	if want, have := len(expected), len(requests); want != have {
+		t.Fatalf("expected %d requests; got: %d (%v)", want, have, requests)
+	}
+	for i := range expected {
+		if want, have := expected[i], requests[i]; want != have {
+			t.Errorf("request #%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
+
+func TestScrollEach(t *testing.T) {
+	tests := []struct {
+		Name        string
+		CallbackErr error
+		Cancel      bool
+		Batches     int
+		Requests    []string
+	}{
+		{
+			Name:    "all batches",
+			Batches: 2,
+			Requests: []string{
+				"POST /tweets/_search",
+				"POST /_search/scroll",
+				"POST /_search/scroll",
+				"DELETE /_search/scroll",
+			},
+		},
+		{
+			Name:        "callback error",
+			CallbackErr: errors.New("stop"),
+			Batches:     1,
+			Requests: []string{
+				"POST /tweets/_search",
+				"DELETE /_search/scroll",
+			},
+		},
+		{
+			Name:    "canceled",
+			Cancel:  true,
+			Batches: 1,
+			Requests: []string{
+				"POST /tweets/_search",
+				"DELETE /_search/scroll",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		var (
+			requests []string
+			scrolls  int
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == "POST" && r.URL.Path == "/tweets/_search":
+				w.Write([]byte(`{"_scroll_id":"c2Nyb2xs","hits":{"total":{"value":3,"relation":"eq"},"hits":[{"_id":"1","_source":{}},{"_id":"2","_source":{}}]}}`))
+			case r.Method == "POST" && r.URL.Path == "/_search/scroll":
+				scrolls++
+				if scrolls == 1 {
+					w.Write([]byte(`{"_scroll_id":"c2Nyb2xs","hits":{"total":{"value":3,"relation":"eq"},"hits":[{"_id":"3","_source":{}}]}}`))
+				} else {
+					w.Write([]byte(`{"_scroll_id":"c2Nyb2xs","hits":{"total":{"value":3,"relation":"eq"},"hits":[]}}`))
+				}
+			case r.Method == "DELETE" && r.URL.Path == "/_search/scroll":
+				w.Write([]byte(`{"succeeded":true,"num_freed":1}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var ids []string
+		batches := 0
+		err = client.Scroll("tweets").Size(2).Each(ctx, func(hits []*SearchHit) error {
+			batches++
+			for _, hit := range hits {
+				ids = append(ids, hit.Id)
+			}
+			if tt.Cancel {
+				cancel()
+			}
+			return tt.CallbackErr
+		})
+		cancel()
+		ts.Close()
+
+		switch {
+		case tt.CallbackErr != nil:
+			if err != tt.CallbackErr {
+				t.Errorf("%s: expected error %v; got: %v", tt.Name, tt.CallbackErr, err)
+			}
+		case tt.Cancel:
+			if err != context.Canceled {
+				t.Errorf("%s: expected error %v; got: %v", tt.Name, context.Canceled, err)
+			}
+		default:
+			if err != nil {
+				t.Errorf("%s: expected no error; got: %v", tt.Name, err)
+			}
+			if want, have := "1,2,3", strings.Join(ids, ","); want != have {
+				t.Errorf("%s: expected ids %s; got: %s", tt.Name, want, have)
+			}
+		}
+		if want, have := tt.Batches, batches; want != have {
+			t.Errorf("%s: expected %d batches; got: %d", tt.Name, want, have)
+		}
+		if want, have := strings.Join(tt.Requests, "\n"), strings.Join(requests, "\n"); want != have {
+			t.Errorf("%s: expected requests\n%s\n,got:\n%s", tt.Name, want, have)
+		}
+	}
+}
@@ -33,6 +33,7 @@ type SearchService struct {
 	expandWildcards   string
 	maxResponseSize   int64
 	seqNoPrimaryTerm  *bool
+	params            url.Values
 }
 
 // NewSearchService creates a new service for searching in Elasticsearch.
@@ -68,6 +69,28 @@ func (s *SearchService) FilterPath(filterPath ...string) *SearchService {
 	return s
 }
 
+// Param adds a custom query string parameter to the request, e.g. one
+// that is not covered by a typed setter of SearchService. Typed setters
+// take precedence over custom parameters of the same name.
+func (s *SearchService) Param(key, value string) *SearchService {
+	if s.params == nil {
+		s.params = url.Values{}
+	}
+	s.params.Add(key, value)
+	return s
+}
+
+// Params adds custom query string parameters to the request.
+// See Param for details.
+func (s *SearchService) Params(params url.Values) *SearchService {
+	for key, values := range params {
+		for _, value := range values {
+			s.Param(key, value)
+		}
+	}
+	return s
+}
+
 // Index sets the names of the indices to use for search.
 func (s *SearchService) Index(index ...string) *SearchService {
 	s.index = append(s.index, index...)
@@ -169,6 +192,13 @@ func (s *SearchService) PostFilter(postFilter Query) *SearchService {
 	return s
 }
 
+// Rescorer adds a rescorer to the search. Calling it more than once
+// chains the rescorers, which are then sent to Elasticsearch as an array.
+func (s *SearchService) Rescorer(rescore *Rescore) *SearchService {
+	s.searchSource = s.searchSource.Rescorer(rescore)
+	return s
+}
+
 // FetchSource indicates whether the response should contain the stored
 // _source for every hit.
 func (s *SearchService) FetchSource(fetchSource bool) *SearchService {
@@ -176,6 +206,15 @@ func (s *SearchService) FetchSource(fetchSource bool) *SearchService {
 	return s
 }
 
+// FetchSourceIncludeExclude specifies that _source should be returned
+// with each hit, where "include" and "exclude" serve as a simple wildcard
+// matcher that gets applied to its fields
+// (e.g. include := []string{"obj1.*","obj2.*"}, exclude := []string{"description.*"}).
+func (s *SearchService) FetchSourceIncludeExclude(include, exclude []string) *SearchService {
+	s.searchSource = s.searchSource.FetchSourceIncludeExclude(include, exclude)
+	return s
+}
+
 // FetchSourceContext indicates how the _source should be fetched.
 func (s *SearchService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *SearchService {
 	s.searchSource = s.searchSource.FetchSourceContext(fetchSourceContext)
@@ -195,7 +234,10 @@ func (s *SearchService) GlobalSuggestText(globalText string) *SearchService {
 	return s
 }
 
-// Suggester adds a suggester to the search.
+// Suggester adds a suggester to the search. Call it several times to
+// run multiple suggesters in a single request; they all share the
+// indices, routing, and preference of the search. Results are keyed by
+// suggester name in SearchResult.Suggest.
 func (s *SearchService) Suggester(suggester Suggester) *SearchService {
 	s.searchSource = s.searchSource.Suggester(suggester)
 	return s
@@ -315,6 +357,8 @@ func (s *SearchService) TrackScores(trackScores bool) *SearchService {
 }
 
 // TrackTotalHits controls if the total hit count for the query should be tracked.
+// It accepts either a bool or an int; the latter counts hits accurately
+// up to the given threshold. With false, SearchResult.TotalHits returns -1.
 //
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.1/search-request-track-total-hits.html
 // for details.
@@ -326,6 +370,8 @@ func (s *SearchService) TrackTotalHits(trackTotalHits interface{}) *SearchServic
 // SearchAfter allows a different form of pagination by using a live cursor,
 // using the results of the previous page to help the retrieval of the next.
 //
+// SearchAfter must be used together with a sort; Do returns an error otherwise.
+//
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.0/search-request-search-after.html
 func (s *SearchService) SearchAfter(sortValues ...interface{}) *SearchService {
 	s.searchSource = s.searchSource.SearchAfter(sortValues...)
@@ -395,6 +441,9 @@ func (s *SearchService) buildURL() (string, url.Values, error) {
 
 	// Add query string parameters
 	params := url.Values{}
+	for key, values := range s.params {
+		params[key] = append([]string(nil), values...)
+	}
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
@@ -430,6 +479,12 @@ func (s *SearchService) buildURL() (string, url.Values, error) {
 
 // Validate checks if the operation is valid.
 func (s *SearchService) Validate() error {
+	// search_after only makes sense with a sort. We can only check this
+	// if the body has not been set by Source.
+	if s.source == nil && s.searchSource != nil &&
+		len(s.searchSource.searchAfterSortValues) > 0 && !s.searchSource.hasSort() {
+		return fmt.Errorf("search_after requires a sort to be specified")
+	}
 	return nil
 }
 
@@ -473,29 +528,42 @@ func (s *SearchService) Do(ctx context.Context) (*SearchResult, error) {
 	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
 		return nil, err
 	}
+	if s.source == nil && s.searchSource != nil {
+		if track, ok := s.searchSource.trackTotalHits.(bool); ok && !track {
+			ret.totalHitsUntracked = true
+		}
+	}
 	return ret, nil
 }
 
 // SearchResult is the result of a search in Elasticsearch.
 type SearchResult struct {
-	TookInMillis int64          `json:"took,omitempty"`         // search time in milliseconds
-	ScrollId     string         `json:"_scroll_id,omitempty"`   // only used with Scroll and Scan operations
-	Hits         *SearchHits    `json:"hits,omitempty"`         // the actual search hits
-	Suggest      SearchSuggest  `json:"suggest,omitempty"`      // results from suggesters
-	Aggregations Aggregations   `json:"aggregations,omitempty"` // results from aggregations
-	TimedOut     bool           `json:"timed_out,omitempty"`    // true if the search timed out
-	Error        *ErrorDetails  `json:"error,omitempty"`        // only used in MultiGet
-	Profile      *SearchProfile `json:"profile,omitempty"`      // profiling results, if optional Profile API was active for this search
-	Shards       *ShardsInfo    `json:"_shards,omitempty"`      // shard information
+	TookInMillis    int64          `json:"took,omitempty"`             // search time in milliseconds
+	ScrollId        string         `json:"_scroll_id,omitempty"`       // only used with Scroll and Scan operations
+	Hits            *SearchHits    `json:"hits,omitempty"`             // the actual search hits
+	Suggest         SearchSuggest  `json:"suggest,omitempty"`          // results from suggesters
+	Aggregations    Aggregations   `json:"aggregations,omitempty"`     // results from aggregations
+	TimedOut        bool           `json:"timed_out,omitempty"`        // true if the search timed out
+	TerminatedEarly bool           `json:"terminated_early,omitempty"` // true if the search was terminated early, e.g. due to TerminateAfter
+	Error           *ErrorDetails  `json:"error,omitempty"`            // only used in MultiGet
+	Profile         *SearchProfile `json:"profile,omitempty"`          // profiling results, if optional Profile API was active for this search
+	Shards          *ShardsInfo    `json:"_shards,omitempty"`          // shard information
+
+	totalHitsUntracked bool // true if the search was executed with track_total_hits set to false
 }
 
 // TotalHits is a convenience function to return the number of hits for
 // a search result. The return value might not be accurate, unless
-// track_total_hits parameter has set to true.
+// track_total_hits parameter has set to true. If track_total_hits has
+// been set to false with SearchService.TrackTotalHits, the total is
+// unknown and -1 is returned.
 func (r *SearchResult) TotalHits() int64 {
 	if r.Hits != nil && r.Hits.TotalHits != nil {
 		return r.Hits.TotalHits.Value
 	}
+	if r.totalHitsUntracked {
+		return -1
+	}
 	return 0
 }
 
@@ -599,16 +667,17 @@ type SearchSuggestion struct {
 // SearchSuggestionOption is an option of a SearchSuggestion.
 // See https://www.elastic.co/guide/en/elasticsearch/reference/7.0/search-suggesters.html.
 type SearchSuggestionOption struct {
-	Text            string          `json:"text"`
-	Index           string          `json:"_index"`
-	Type            string          `json:"_type"`
-	Id              string          `json:"_id"`
-	Score           float64         `json:"score"`  // term and phrase suggesters uses "score" as of 6.2.4
-	ScoreUnderscore float64         `json:"_score"` // completion and context suggesters uses "_score" as of 6.2.4
-	Highlighted     string          `json:"highlighted"`
-	CollateMatch    bool            `json:"collate_match"`
-	Freq            int             `json:"freq"` // from TermSuggestion.Option in Java API
-	Source          json.RawMessage `json:"_source"`
+	Text            string              `json:"text"`
+	Index           string              `json:"_index"`
+	Type            string              `json:"_type"`
+	Id              string              `json:"_id"`
+	Score           float64             `json:"score"`  // term and phrase suggesters uses "score" as of 6.2.4
+	ScoreUnderscore float64             `json:"_score"` // completion and context suggesters uses "_score" as of 6.2.4
+	Highlighted     string              `json:"highlighted"`
+	CollateMatch    bool                `json:"collate_match"`
+	Freq            int                 `json:"freq"` // from TermSuggestion.Option in Java API
+	Source          json.RawMessage     `json:"_source"`
+	Contexts        map[string][]string `json:"contexts,omitempty"` // completion suggesters with contexts
 }
 
 // SearchProfile is a list of shard profiling data collected during
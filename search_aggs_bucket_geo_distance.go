@@ -17,6 +17,7 @@ type GeoDistanceAggregation struct {
 	unit            string
 	distanceType    string
 	point           string
+	origin          *GeoPoint
 	ranges          []geoDistAggRange
 	subAggregations map[string]Aggregation
 	meta            map[string]interface{}
@@ -55,6 +56,13 @@ func (a *GeoDistanceAggregation) Point(latLon string) *GeoDistanceAggregation {
 	return a
 }
 
+// Origin sets the point of origin as a GeoPoint. It takes precedence
+// over a point specified as a string via Point.
+func (a *GeoDistanceAggregation) Origin(origin *GeoPoint) *GeoDistanceAggregation {
+	a.origin = origin
+	return a
+}
+
 func (a *GeoDistanceAggregation) SubAggregation(name string, subAggregation Aggregation) *GeoDistanceAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -138,7 +146,9 @@ func (a *GeoDistanceAggregation) Source() (interface{}, error) {
 	if a.distanceType != "" {
 		opts["distance_type"] = a.distanceType
 	}
-	if a.point != "" {
+	if a.origin != nil {
+		opts["origin"] = a.origin.Source()
+	} else if a.point != "" {
 		opts["origin"] = a.point
 	}
 
@@ -91,3 +91,25 @@ func TestGeoDistanceAggregationWithMetaData(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoDistanceAggregationWithOriginAndUnit(t *testing.T) {
+	agg := NewGeoDistanceAggregation().
+		Field("location").
+		Origin(GeoPointFromLatLon(52.376, 4.894)).
+		Unit("km").
+		AddRangeWithKey("near", nil, 100).
+		AddRangeWithKey("far", 100, nil)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_distance":{"field":"location","origin":{"lat":52.376,"lon":4.894},"ranges":[{"key":"near","to":100},{"from":100,"key":"far"}],"unit":"km"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -100,3 +100,21 @@ func TestGeoHashGridAggregationWithShardSize(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoHashGridAggregationWithPrecisionAndSize(t *testing.T) {
+	agg := NewGeoHashGridAggregation().Field("location").Precision(5).Size(100)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("Marshalling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geohash_grid":{"field":"location","precision":5,"size":100}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
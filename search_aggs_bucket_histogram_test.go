@@ -59,3 +59,25 @@ func TestHistogramAggregationWithMissing(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHistogramAggregationWithBoundsAndOrder(t *testing.T) {
+	agg := NewHistogramAggregation().
+		Field("price").
+		Interval(50).
+		MinDocCount(0).
+		ExtendedBounds(0, 500).
+		OrderByKeyDesc()
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"histogram":{"extended_bounds":{"max":500,"min":0},"field":"price","interval":50,"min_doc_count":0,"order":{"_key":"desc"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
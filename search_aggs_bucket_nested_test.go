@@ -60,3 +60,23 @@ func TestNestedAggregationWithMetaData(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestNestedAggregationWithTermsAndReverseNestedSubAggregations(t *testing.T) {
+	agg := NewNestedAggregation().Path("comments").
+		SubAggregation("top_commenters", NewTermsAggregation().Field("comments.user").Size(5).
+			SubAggregation("posts", NewReverseNestedAggregation().
+				SubAggregation("tags", NewTermsAggregation().Field("tags"))))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"top_commenters":{"aggregations":{"posts":{"aggregations":{"tags":{"terms":{"field":"tags"}}},"reverse_nested":{}}},"terms":{"field":"comments.user","size":5}}},"nested":{"path":"comments"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -58,6 +58,11 @@ func (a *SignificantTermsAggregation) RequiredSize(requiredSize int) *Significan
 	return a
 }
 
+// Size is an alias for RequiredSize.
+func (a *SignificantTermsAggregation) Size(size int) *SignificantTermsAggregation {
+	return a.RequiredSize(size)
+}
+
 func (a *SignificantTermsAggregation) ShardSize(shardSize int) *SignificantTermsAggregation {
 	a.shardSize = &shardSize
 	return a
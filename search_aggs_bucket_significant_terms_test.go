@@ -107,6 +107,28 @@ func TestSignificantTermsAggregationWithChiSquare(t *testing.T) {
 	}
 }
 
+func TestSignificantTermsAggregationWithChiSquareAndBackgroundFilter(t *testing.T) {
+	agg := NewSignificantTermsAggregation().
+		Field("crime_type").
+		Size(10).
+		MinDocCount(3).
+		BackgroundFilter(NewTermQuery("city", "london")).
+		SignificanceHeuristic(NewChiSquareSignificanceHeuristic().IncludeNegatives(true))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"significant_terms":{"background_filter":{"term":{"city":"london"}},"chi_square":{"include_negatives":true},"field":"crime_type","min_doc_count":3,"size":10}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSignificantTermsAggregationWithGND(t *testing.T) {
 	agg := NewSignificantTermsAggregation().Field("crime_type")
 	agg = agg.SignificanceHeuristic(
@@ -153,3 +153,26 @@ func TestTermsAggregationWithPartitions(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTermsAggregationWithTwoLevelSubAggregations(t *testing.T) {
+	salesPerMonth := NewDateHistogramAggregation().Field("sold").Interval("month").
+		SubAggregation("total", NewSumAggregation().Field("price")).
+		SubAggregation("highest", NewMaxAggregation().Field("price"))
+	agg := NewTermsAggregation().Field("make").Size(5).
+		SubAggregation("avg_price", NewAvgAggregation().Field("price")).
+		SubAggregation("cheapest", NewMinAggregation().Field("price")).
+		SubAggregation("sales_per_month", salesPerMonth)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"avg_price":{"avg":{"field":"price"}},"cheapest":{"min":{"field":"price"}},"sales_per_month":{"aggregations":{"highest":{"max":{"field":"price"}},"total":{"sum":{"field":"price"}}},"date_histogram":{"field":"sold","interval":"month"}}},"terms":{"field":"make","size":5}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -68,3 +68,26 @@ func TestScriptedMetricAggregationWithMeta(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestScriptedMetricAggregationWithAllPhasesAndParams(t *testing.T) {
+	agg := NewScriptedMetricAggregation().
+		InitScript(NewScript("state.sum = 0")).
+		MapScript(NewScript("state.sum += doc.amount.value * params.factor")).
+		CombineScript(NewScript("return state.sum")).
+		ReduceScript(NewScript("double total = 0; for (s in states) { total += s } return total").Lang("painless")).
+		Params(map[string]interface{}{"factor": 1.5})
+
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"scripted_metric":{"combine_script":{"source":"return state.sum"},"init_script":{"source":"state.sum = 0"},"map_script":{"source":"state.sum += doc.amount.value * params.factor"},"params":{"factor":1.5},"reduce_script":{"lang":"painless","source":"double total = 0; for (s in states) { total += s } return total"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -29,3 +29,26 @@ func TestTopHitsAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTopHitsAggregationInsideTermsAggregation(t *testing.T) {
+	topHits := NewTopHitsAggregation().
+		From(1).
+		Size(2).
+		SortBy(NewFieldSort("created").Desc(), NewScoreSort()).
+		FetchSource(false).
+		Highlight(NewHighlight().Field("message"))
+	agg := NewTermsAggregation().Field("user").SubAggregation("latest_tweets", topHits)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"latest_tweets":{"top_hits":{"_source":false,"from":1,"highlight":{"fields":{"message":{}}},"size":2,"sort":[{"created":{"order":"desc"}},{"_score":{"order":"desc"}}]}}},"terms":{"field":"user"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
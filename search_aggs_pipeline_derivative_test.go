@@ -25,3 +25,22 @@ func TestDerivativeAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestDerivativeAggregationInsideDateHistogram(t *testing.T) {
+	h := NewDateHistogramAggregation().Field("date").Interval("month")
+	h = h.SubAggregation("sales", NewSumAggregation().Field("price"))
+	h = h.SubAggregation("sales_deriv", NewDerivativeAggregation().BucketsPath("sales").Unit("day"))
+	src, err := h.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"sales":{"sum":{"field":"price"}},"sales_deriv":{"derivative":{"buckets_path":"sales","unit":"day"}}},"date_histogram":{"field":"date","interval":"month"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -2740,6 +2740,73 @@ func TestAggsBucketTermsWithBoolKeys(t *testing.T) {
 	}
 }
 
+func TestAggsBucketTermsWithAvgSubAggregation(t *testing.T) {
+	s := `{
+	"users" : {
+	  "doc_count_error_upper_bound" : 0,
+	  "sum_other_doc_count" : 0,
+	  "buckets" : [ {
+	    "key" : "olivere",
+	    "doc_count" : 2,
+	    "avg_retweets" : { "value" : 54.5 }
+	  }, {
+	    "key" : "sandrae",
+	    "doc_count" : 1,
+	    "avg_retweets" : { "value" : 12.0 }
+	  } ]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	if _, found := aggs.Terms("no-such-aggregation"); found {
+		t.Fatalf("expected aggregation to not be found; got: %v", found)
+	}
+
+	agg, found := aggs.Terms("users")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d bucket entries; got: %d", 2, len(agg.Buckets))
+	}
+
+	expected := []struct {
+		Key      string
+		DocCount int64
+		Avg      float64
+	}{
+		{"olivere", 2, 54.5},
+		{"sandrae", 1, 12.0},
+	}
+	for i, want := range expected {
+		bucket := agg.Buckets[i]
+		if bucket.Key != want.Key {
+			t.Errorf("bucket #%d: expected key %q; got: %q", i, want.Key, bucket.Key)
+		}
+		if bucket.DocCount != want.DocCount {
+			t.Errorf("bucket #%d: expected doc count %d; got: %d", i, want.DocCount, bucket.DocCount)
+		}
+		subAgg, found := bucket.Avg("avg_retweets")
+		if !found {
+			t.Fatalf("bucket #%d: expected sub-aggregation to be found; got: %v", i, found)
+		}
+		if subAgg.Value == nil {
+			t.Fatalf("bucket #%d: expected sub-aggregation value != nil; got: %v", i, subAgg.Value)
+		}
+		if *subAgg.Value != want.Avg {
+			t.Errorf("bucket #%d: expected sub-aggregation value = %v; got: %v", i, want.Avg, *subAgg.Value)
+		}
+		if _, found := bucket.Avg("no-such-sub-aggregation"); found {
+			t.Errorf("bucket #%d: expected sub-aggregation to not be found; got: %v", i, found)
+		}
+	}
+}
+
 func TestAggsBucketSignificantTerms(t *testing.T) {
 	s := `{
 	"significantCrimeTypes" : {
@@ -3440,6 +3507,87 @@ func TestAggsBucketGeoDistance(t *testing.T) {
 	}
 }
 
+func TestAggsBucketComposite(t *testing.T) {
+	s := `{
+	"my_buckets": {
+		"after_key": {
+			"product": "mad max",
+			"price": 20.0
+		},
+		"buckets": [
+			{
+				"key": {
+					"product": "rocky",
+					"price": 10.0
+				},
+				"doc_count": 3
+			},
+			{
+				"key": {
+					"product": "mad max",
+					"price": 20.0
+				},
+				"doc_count": 1
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Composite("my_buckets")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d bucket entries; got: %d", 2, len(agg.Buckets))
+	}
+	if want, have := "rocky", agg.Buckets[0].Key["product"]; want != have {
+		t.Errorf("expected Buckets[0].Key[product] = %q; got: %v", want, have)
+	}
+	if want, have := int64(3), agg.Buckets[0].DocCount; want != have {
+		t.Errorf("expected Buckets[0].DocCount = %d; got: %d", want, have)
+	}
+	if agg.AfterKey == nil {
+		t.Fatalf("expected after_key; got: %v", agg.AfterKey)
+	}
+	if want, have := "mad max", agg.AfterKey["product"]; want != have {
+		t.Errorf("expected after_key.product = %q; got: %v", want, have)
+	}
+	if want, have := float64(20), agg.AfterKey["price"]; want != have {
+		t.Errorf("expected after_key.price = %v; got: %v", want, have)
+	}
+
+	// The after_key can be passed as-is to fetch the next page
+	next := NewCompositeAggregation().
+		Sources(
+			NewCompositeAggregationTermsValuesSource("product").Field("product"),
+			NewCompositeAggregationHistogramValuesSource("price", 10).Field("price"),
+		).
+		Size(2).
+		AggregateAfter(agg.AfterKey)
+	src, err := next.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"composite":{"after":{"price":20,"product":"mad max"},"size":2,"sources":[{"product":{"terms":{"field":"product"}}},{"price":{"histogram":{"field":"price","interval":10}}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestAggsSubAggregates(t *testing.T) {
 	rs := `{
 	"users" : {
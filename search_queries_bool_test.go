@@ -26,7 +26,48 @@ func TestBoolQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"bool":{"_name":"Test","boost":10,"filter":{"term":{"account":"1"}},"must":{"term":{"tag":"wow"}},"must_not":{"range":{"age":{"from":10,"include_lower":true,"include_upper":true,"to":20}}},"should":[{"term":{"tag":"sometag"}},{"term":{"tag":"sometagtag"}}]}}`
+	expected := `{"bool":{"_name":"Test","boost":10,"filter":{"term":{"account":"1"}},"must":{"term":{"tag":"wow"}},"must_not":{"range":{"age":{"gte":10,"lte":20}}},"should":[{"term":{"tag":"sometag"}},{"term":{"tag":"sometagtag"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestBoolQueryWithMustAndNestedFilter(t *testing.T) {
+	q := NewBoolQuery().
+		Must(NewMatchQuery("title", "search"), NewMatchQuery("content", "elasticsearch")).
+		Filter(
+			NewTermQuery("status", "published"),
+			NewBoolQuery().
+				Should(NewTermQuery("tag", "go"), NewTermQuery("tag", "golang")).
+				MinimumShouldMatch("1"),
+		)
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bool":{"filter":[{"term":{"status":"published"}},{"bool":{"minimum_should_match":"1","should":[{"term":{"tag":"go"}},{"term":{"tag":"golang"}}]}}],"must":[{"match":{"title":"search"}},{"match":{"content":"elasticsearch"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestBoolQueryWithoutClauses(t *testing.T) {
+	q := NewBoolQuery()
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bool":{}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
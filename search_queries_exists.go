@@ -11,6 +11,7 @@ package elastic
 // https://www.elastic.co/guide/en/elasticsearch/reference/7.0/query-dsl-exists-query.html
 type ExistsQuery struct {
 	name      string
+	boost     *float64
 	queryName string
 }
 
@@ -21,6 +22,19 @@ func NewExistsQuery(name string) *ExistsQuery {
 	}
 }
 
+// NewMissingQuery creates a query that only matches on documents that
+// have no value in the given field. Elasticsearch has no missing query
+// any more, so this is a bool query with an exists query in must_not.
+func NewMissingQuery(name string) *BoolQuery {
+	return NewBoolQuery().MustNot(NewExistsQuery(name))
+}
+
+// Boost sets the boost for this query.
+func (q *ExistsQuery) Boost(boost float64) *ExistsQuery {
+	q.boost = &boost
+	return q
+}
+
 // QueryName sets the query name for the filter that can be used
 // when searching for matched queries per hit.
 func (q *ExistsQuery) QueryName(queryName string) *ExistsQuery {
@@ -41,6 +55,9 @@ func (q *ExistsQuery) Source() (interface{}, error) {
 	query["exists"] = params
 
 	params["field"] = q.name
+	if q.boost != nil {
+		params["boost"] = *q.boost
+	}
 	if q.queryName != "" {
 		params["_name"] = q.queryName
 	}
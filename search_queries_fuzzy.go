@@ -89,6 +89,13 @@ func (q *FuzzyQuery) Source() (interface{}, error) {
 	query := make(map[string]interface{})
 	source["fuzzy"] = query
 
+	if q.boost == nil && q.transpositions == nil && q.fuzziness == nil &&
+		q.prefixLength == nil && q.maxExpansions == nil && q.rewrite == "" && q.queryName == "" {
+		// Short form, e.g. { "fuzzy" : { "user" : "ki" } }
+		query[q.name] = q.value
+		return source, nil
+	}
+
 	fq := make(map[string]interface{})
 	query[q.name] = fq
 
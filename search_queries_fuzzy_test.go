@@ -25,3 +25,37 @@ func TestFuzzyQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFuzzyQueryCompactAndExpandedForms(t *testing.T) {
+	tests := []struct {
+		Query    *FuzzyQuery
+		Expected string
+	}{
+		{
+			NewFuzzyQuery("user", "ki"),
+			`{"fuzzy":{"user":"ki"}}`,
+		},
+		{
+			NewFuzzyQuery("user", "ki").Fuzziness("AUTO"),
+			`{"fuzzy":{"user":{"fuzziness":"AUTO","value":"ki"}}}`,
+		},
+		{
+			NewFuzzyQuery("user", "ki").Fuzziness(1).PrefixLength(1).MaxExpansions(50).Transpositions(false).Boost(2),
+			`{"fuzzy":{"user":{"boost":2,"fuzziness":1,"max_expansions":50,"prefix_length":1,"transpositions":false,"value":"ki"}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Query.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
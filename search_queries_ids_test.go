@@ -25,3 +25,37 @@ func TestIdsQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestIdsQueryWithoutAndWithMultipleTypes(t *testing.T) {
+	tests := []struct {
+		Query    *IdsQuery
+		Expected string
+	}{
+		{
+			NewIdsQuery().Ids("1", "4", "100"),
+			`{"ids":{"values":["1","4","100"]}}`,
+		},
+		{
+			NewIdsQuery().Ids("1").Ids("4").Boost(2),
+			`{"ids":{"boost":2,"values":["1","4"]}}`,
+		},
+		{
+			NewIdsQuery("tweet", "comment").Ids("1", "4"),
+			`{"ids":{"types":["tweet","comment"],"values":["1","4"]}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Query.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
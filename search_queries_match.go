@@ -134,7 +134,8 @@ func (q *MatchQuery) QueryName(queryName string) *MatchQuery {
 
 // Source returns JSON for the function score query.
 func (q *MatchQuery) Source() (interface{}, error) {
-	// {"match":{"name":{"query":"value","type":"boolean/phrase"}}}
+	// {"match":{"name":{"query":"value","operator":"and"}}}
+	// or, without any options, {"match":{"name":"value"}}
 	source := make(map[string]interface{})
 
 	match := make(map[string]interface{})
@@ -185,5 +186,10 @@ func (q *MatchQuery) Source() (interface{}, error) {
 		query["_name"] = q.queryName
 	}
 
+	if len(query) == 1 {
+		// Short form, e.g. { "match" : { "message" : "this is a test" } }
+		match[q.name] = q.text
+	}
+
 	return source, nil
 }
@@ -20,7 +20,7 @@ func TestMatchQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"match":{"message":{"query":"this is a test"}}}`
+	expected := `{"match":{"message":"this is a test"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -42,3 +42,67 @@ func TestMatchQueryWithOptions(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestMatchQueryWithFuzzyOptions(t *testing.T) {
+	q := NewMatchQuery("message", "this is a tset").
+		Operator("and").
+		Fuzziness("AUTO").
+		PrefixLength(1).
+		MaxExpansions(10).
+		MinimumShouldMatch("75%")
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match":{"message":{"fuzziness":"AUTO","max_expansions":10,"minimum_should_match":"75%","operator":"and","prefix_length":1,"query":"this is a tset"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMatchQueryCompactAndExpandedForms(t *testing.T) {
+	tests := []struct {
+		Query    *MatchQuery
+		Expected string
+	}{
+		{
+			NewMatchQuery("message", "this is a test"),
+			`{"match":{"message":"this is a test"}}`,
+		},
+		{
+			NewMatchQuery("retweets", 42),
+			`{"match":{"retweets":42}}`,
+		},
+		{
+			NewMatchQuery("message", "this is a test").Operator("and"),
+			`{"match":{"message":{"operator":"and","query":"this is a test"}}}`,
+		},
+		{
+			NewMatchQuery("message", "this is a test").Boost(2),
+			`{"match":{"message":{"boost":2,"query":"this is a test"}}}`,
+		},
+		{
+			NewMatchQuery("message", "this is a test").QueryName("my_query"),
+			`{"match":{"message":{"_name":"my_query","query":"this is a test"}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Query.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
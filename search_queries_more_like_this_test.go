@@ -49,6 +49,51 @@ func TestMoreLikeThisQuerySourceWithLikeAndUnlikeItems(t *testing.T) {
 	}
 }
 
+func TestMoreLikeThisQuerySourceWithTuningParameters(t *testing.T) {
+	tests := []struct {
+		Query    *MoreLikeThisQuery
+		Expected string
+	}{
+		// Text-based
+		{
+			NewMoreLikeThisQuery().
+				Field("title", "description").
+				LikeText("Once upon a time", "In a galaxy far far away").
+				MinTermFreq(1).
+				MaxQueryTerms(12).
+				MinDocFreq(5).
+				MinimumShouldMatch("30%"),
+			`{"more_like_this":{"fields":["title","description"],"like":["Once upon a time","In a galaxy far far away"],"max_query_terms":12,"min_doc_freq":5,"min_term_freq":1,"minimum_should_match":"30%"}}`,
+		},
+		// Document references
+		{
+			NewMoreLikeThisQuery().
+				Field("title").
+				LikeItems(
+					NewMoreLikeThisQueryItem().Index("imdb").Id("1"),
+					NewMoreLikeThisQueryItem().Index("imdb").Id("2"),
+				).
+				MinTermFreq(2).
+				MaxQueryTerms(25),
+			`{"more_like_this":{"fields":["title"],"like":[{"_id":"1","_index":"imdb"},{"_id":"2","_index":"imdb"}],"max_query_terms":25,"min_term_freq":2}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Query.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
+
 func TestMoreLikeThisQuery(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
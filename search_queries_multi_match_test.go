@@ -146,3 +146,47 @@ func TestMultiMatchQueryBoolPrefix(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestMultiMatchQueryBestFieldsWithBoostedFields(t *testing.T) {
+	q := NewMultiMatchQuery("quick brown fox").
+		FieldWithBoost("subject", 3).
+		Field("message").
+		FieldWithBoost("tags", 1.5).
+		Type("best_fields").
+		Operator("and").
+		Fuzziness("AUTO")
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"multi_match":{"fields":["subject^3.000000","message","tags^1.500000"],"fuzziness":"AUTO","operator":"and","query":"quick brown fox","tie_breaker":0,"type":"best_fields"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMultiMatchQueryCrossFieldsWithOperatorAndTieBreaker(t *testing.T) {
+	q := NewMultiMatchQuery("Will Smith", "first_name", "last_name").
+		FieldWithBoost("full_name", 2).
+		Type("cross_fields").
+		Operator("and").
+		TieBreaker(0.3)
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"multi_match":{"fields":["first_name","last_name","full_name^2.000000"],"operator":"and","query":"Will Smith","tie_breaker":0.3,"type":"cross_fields"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
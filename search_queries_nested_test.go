@@ -23,7 +23,7 @@ func TestNestedQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"nested":{"_name":"qname","path":"obj1","query":{"bool":{"must":[{"term":{"obj1.name":"blue"}},{"range":{"obj1.count":{"from":5,"include_lower":false,"include_upper":true,"to":null}}}]}}}}`
+	expected := `{"nested":{"_name":"qname","path":"obj1","query":{"bool":{"must":[{"term":{"obj1.name":"blue"}},{"range":{"obj1.count":{"gt":5}}}]}}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -45,7 +45,7 @@ func TestNestedQueryWithInnerHit(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"nested":{"_name":"qname","inner_hits":{"name":"comments","query":{"term":{"user":"olivere"}}},"path":"obj1","query":{"bool":{"must":[{"term":{"obj1.name":"blue"}},{"range":{"obj1.count":{"from":5,"include_lower":false,"include_upper":true,"to":null}}}]}}}}`
+	expected := `{"nested":{"_name":"qname","inner_hits":{"name":"comments","query":{"term":{"user":"olivere"}}},"path":"obj1","query":{"bool":{"must":[{"term":{"obj1.name":"blue"}},{"range":{"obj1.count":{"gt":5}}}]}}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -84,3 +84,68 @@ func TestNestedQueryWithIgnoreUnmapped(t *testing.T) {
 		}
 	}
 }
+
+func TestNestedQueryWithScoreModeAndPagedInnerHit(t *testing.T) {
+	q := NewNestedQuery("comments", NewMatchQuery("comments.text", "go")).
+		ScoreMode("max").
+		Boost(2).
+		InnerHit(NewInnerHit().Name("top_comments").From(0).Size(3).Sort("comments.votes", false))
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"nested":{"boost":2,"inner_hits":{"from":0,"name":"top_comments","size":3,"sort":[{"comments.votes":{"order":"desc"}}]},"path":"comments","query":{"match":{"comments.text":"go"}},"score_mode":"max"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestNestedQueryInnerHitsDecoding(t *testing.T) {
+	js := `{
+		"_index": "posts",
+		"_id": "1",
+		"_score": 1.2,
+		"_source": {"title": "Elastic"},
+		"inner_hits": {
+			"top_comments": {
+				"hits": {
+					"total": {"value": 2, "relation": "eq"},
+					"max_score": 1.2,
+					"hits": [
+						{"_index": "posts", "_id": "1", "_nested": {"field": "comments", "offset": 1}, "_score": 1.2, "_source": {"text": "go rocks"}},
+						{"_index": "posts", "_id": "1", "_nested": {"field": "comments", "offset": 0}, "_score": 0.7, "_source": {"text": "go go go"}}
+					]
+				}
+			}
+		}
+	}`
+	var hit SearchHit
+	if err := json.Unmarshal([]byte(js), &hit); err != nil {
+		t.Fatal(err)
+	}
+	inner, found := hit.InnerHits["top_comments"]
+	if !found || inner == nil || inner.Hits == nil {
+		t.Fatalf("expected inner hits named %q; got: %v", "top_comments", hit.InnerHits)
+	}
+	if want, have := int64(2), inner.Hits.TotalHits.Value; want != have {
+		t.Errorf("expected %d inner hits; got: %d", want, have)
+	}
+	if want, have := 2, len(inner.Hits.Hits); want != have {
+		t.Fatalf("expected %d inner hits; got: %d", want, have)
+	}
+	nested := inner.Hits.Hits[0].Nested
+	if nested == nil {
+		t.Fatal("expected _nested identity")
+	}
+	if want, have := "comments", nested.Field; want != have {
+		t.Errorf("expected _nested.field %q; got: %q", want, have)
+	}
+	if want, have := 1, nested.Offset; want != have {
+		t.Errorf("expected _nested.offset %d; got: %d", want, have)
+	}
+}
@@ -130,8 +130,20 @@ func (q *RangeQuery) Source() (interface{}, error) {
 	params := make(map[string]interface{})
 	rangeQ[q.name] = params
 
-	params["from"] = q.from
-	params["to"] = q.to
+	if q.from != nil {
+		if q.includeLower {
+			params["gte"] = q.from
+		} else {
+			params["gt"] = q.from
+		}
+	}
+	if q.to != nil {
+		if q.includeUpper {
+			params["lte"] = q.to
+		} else {
+			params["lt"] = q.to
+		}
+	}
 	if q.timeZone != "" {
 		params["time_zone"] = q.timeZone
 	}
@@ -144,9 +156,6 @@ func (q *RangeQuery) Source() (interface{}, error) {
 	if q.boost != nil {
 		params["boost"] = *q.boost
 	}
-	params["include_lower"] = q.includeLower
-	params["include_upper"] = q.includeUpper
-
 	if q.queryName != "" {
 		rangeQ["_name"] = q.queryName
 	}
@@ -74,7 +74,7 @@ func (q *RegexpQuery) Source() (interface{}, error) {
 		x["rewrite"] = q.rewrite
 	}
 	if q.queryName != "" {
-		x["name"] = q.queryName
+		x["_name"] = q.queryName
 	}
 	query[q.name] = x
 
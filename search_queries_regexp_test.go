@@ -40,8 +40,50 @@ func TestRegexpQueryWithOptions(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"regexp":{"name.first":{"boost":1.2,"flags":"INTERSECTION|COMPLEMENT|EMPTY","name":"my_query_name","value":"s.*y"}}}`
+	expected := `{"regexp":{"name.first":{"_name":"my_query_name","boost":1.2,"flags":"INTERSECTION|COMPLEMENT|EMPTY","value":"s.*y"}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestPrefixWildcardAndRegexpQueries(t *testing.T) {
+	tests := []struct {
+		Query    Query
+		Expected string
+	}{
+		{
+			NewPrefixQuery("user", "ki").Boost(2).Rewrite("constant_score"),
+			`{"prefix":{"user":{"boost":2,"rewrite":"constant_score","value":"ki"}}}`,
+		},
+		{
+			NewWildcardQuery("user", "ki*y").Boost(1.5).Rewrite("top_terms_10"),
+			`{"wildcard":{"user":{"boost":1.5,"rewrite":"top_terms_10","wildcard":"ki*y"}}}`,
+		},
+		{
+			NewWildcardQuery("path", "/var/log/*.log"),
+			`{"wildcard":{"path":{"wildcard":"/var/log/*.log"}}}`,
+		},
+		{
+			NewRegexpQuery("user", "k.*y").Flags("ALL").MaxDeterminizedStates(10000).Rewrite("constant_score"),
+			`{"regexp":{"user":{"flags":"ALL","max_determinized_states":10000,"rewrite":"constant_score","value":"k.*y"}}}`,
+		},
+		{
+			NewRegexpQuery("user", "k[a-z]+y").Flags("INTERSECTION|COMPLEMENT").Boost(1.2).QueryName("users"),
+			`{"regexp":{"user":{"_name":"users","boost":1.2,"flags":"INTERSECTION|COMPLEMENT","value":"k[a-z]+y"}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Query.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
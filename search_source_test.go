@@ -204,6 +204,27 @@ func TestSearchSourceRescoring(t *testing.T) {
 	}
 }
 
+func TestSearchSourceGlobalSuggestText(t *testing.T) {
+	builder := NewSearchSource().
+		GlobalSuggestText("the amsterdma meetpu").
+		Suggester(NewTermSuggester("my-suggest-1").Field("body")).
+		Suggester(NewPhraseSuggester("my-suggest-2").Field("title")).
+		Suggester(NewTermSuggester("my-suggest-3").Text("rottredam").Field("body"))
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"suggest":{"my-suggest-1":{"term":{"field":"body"}},"my-suggest-2":{"phrase":{"field":"title"}},"my-suggest-3":{"text":"rottredam","term":{"field":"body"}},"text":"the amsterdma meetpu"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSearchSourceIndexBoost(t *testing.T) {
 	matchAllQ := NewMatchAllQuery()
 	builder := NewSearchSource().Query(matchAllQ).IndexBoost("index1", 1.4).IndexBoost("index2", 1.3)
@@ -222,6 +243,45 @@ func TestSearchSourceIndexBoost(t *testing.T) {
 	}
 }
 
+func TestSearchSourceSortByGeoDistance(t *testing.T) {
+	builder := NewSearchSource().Query(NewMatchAllQuery()).
+		SortBy(NewGeoDistanceSort("location").Point(52.52, 13.40).Asc().Unit("km"))
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"sort":[{"_geo_distance":{"location":[{"lat":52.52,"lon":13.4}],"order":"asc","unit":"km"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceMixFieldAndGeoDistanceSorters(t *testing.T) {
+	builder := NewSearchSource().Query(NewMatchAllQuery()).
+		SortBy(
+			NewFieldSort("rating").Desc(),
+			NewGeoDistanceSort("location").Points(GeoPointFromLatLon(52.52, 13.40)).Asc().SortMode("min").DistanceType("arc"),
+		)
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"sort":[{"rating":{"order":"desc"}},{"_geo_distance":{"distance_type":"arc","location":[{"lat":52.52,"lon":13.4}],"mode":"min","order":"asc"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSearchSourceMixDifferentSorters(t *testing.T) {
 	matchAllQ := NewMatchAllQuery()
 	builder := NewSearchSource().Query(matchAllQ).
@@ -257,7 +317,7 @@ func TestSearchSourceInnerHits(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"inner_hits":{"comments":{"type":{"comment":{"query":{"match":{"user":{"query":"olivere"}}}}}},"views":{"path":{"view":{}}}},"query":{"match_all":{}}}`
+	expected := `{"inner_hits":{"comments":{"type":{"comment":{"query":{"match":{"user":"olivere"}}}}},"views":{"path":{"view":{}}}},"query":{"match_all":{}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -6,6 +6,10 @@ package elastic
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -366,3 +370,204 @@ func TestContextSuggester(t *testing.T) {
 		t.Errorf("expected options[0].ScoreUnderscore > 0.0; got %v", score)
 	}
 }
+
+func TestCompletionSuggesterResultWithSourceAndContexts(t *testing.T) {
+	body := `{
+		"took": 2,
+		"timed_out": false,
+		"_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0},
+		"hits": {"total": {"value": 0, "relation": "eq"}, "max_score": 0.0, "hits": []},
+		"suggest": {
+			"place_suggestion": [
+				{
+					"text": "tim",
+					"offset": 0,
+					"length": 3,
+					"options": [
+						{
+							"text": "Tim Hortons",
+							"_index": "place",
+							"_type": "_doc",
+							"_id": "1",
+							"_score": 1.0,
+							"_source": {"suggest": ["Tim Hortons"], "rating": 4},
+							"contexts": {"place_type": ["cafe", "food"]}
+						}
+					]
+				}
+			],
+			"term_suggestion": [
+				{
+					"text": "goolang",
+					"offset": 0,
+					"length": 7,
+					"options": [
+						{"text": "golang", "score": 0.8, "freq": 2}
+					]
+				}
+			]
+		}
+	}`
+
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	completion, found := res.Suggest["place_suggestion"]
+	if !found {
+		t.Fatalf("expected to find SearchResult.Suggest[%s]; got false", "place_suggestion")
+	}
+	if len(completion) != 1 || len(completion[0].Options) != 1 {
+		t.Fatalf("expected 1 suggestion with 1 option; got %+v", completion)
+	}
+	option := completion[0].Options[0]
+	if want, have := "1", option.Id; want != have {
+		t.Errorf("expected Id = %q; got %q", want, have)
+	}
+	var source struct {
+		Rating int `json:"rating"`
+	}
+	if err := json.Unmarshal(option.Source, &source); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 4, source.Rating; want != have {
+		t.Errorf("expected Source.Rating = %d; got %d", want, have)
+	}
+	if want, have := 2, len(option.Contexts["place_type"]); want != have {
+		t.Fatalf("expected %d contexts for place_type; got %d", want, have)
+	}
+	if want, have := "cafe", option.Contexts["place_type"][0]; want != have {
+		t.Errorf("expected context %q; got %q", want, have)
+	}
+
+	term, found := res.Suggest["term_suggestion"]
+	if !found {
+		t.Fatalf("expected to find SearchResult.Suggest[%s]; got false", "term_suggestion")
+	}
+	option = term[0].Options[0]
+	if option.Source != nil {
+		t.Errorf("expected no Source for term suggestion; got %s", string(option.Source))
+	}
+	if option.Contexts != nil {
+		t.Errorf("expected no Contexts for term suggestion; got %v", option.Contexts)
+	}
+	if want, have := 2, option.Freq; want != have {
+		t.Errorf("expected Freq = %d; got %d", want, have)
+	}
+}
+
+func TestSearchSuggestResultWithShardFailures(t *testing.T) {
+	body := `{
+		"took": 3,
+		"timed_out": false,
+		"_shards": {
+			"total": 3,
+			"successful": 2,
+			"skipped": 1,
+			"failed": 1,
+			"failures": [
+				{
+					"shard": 1,
+					"index": "songs",
+					"node": "V1StGXR8",
+					"reason": {"type": "node_not_connected_exception", "reason": "[node-2] Node not connected"}
+				}
+			]
+		},
+		"hits": {"total": {"value": 0, "relation": "eq"}, "max_score": null, "hits": []},
+		"suggest": {
+			"song-suggest": [
+				{"text": "nir", "offset": 0, "length": 3, "options": [{"text": "Nirvana", "_id": "1", "_score": 1.0}]}
+			]
+		}
+	}`
+
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Shards == nil {
+		t.Fatal("expected SearchResult.Shards != nil; got nil")
+	}
+	if want, have := 3, res.Shards.Total; want != have {
+		t.Errorf("expected Shards.Total = %d; got %d", want, have)
+	}
+	if want, have := 2, res.Shards.Successful; want != have {
+		t.Errorf("expected Shards.Successful = %d; got %d", want, have)
+	}
+	if want, have := 1, res.Shards.Skipped; want != have {
+		t.Errorf("expected Shards.Skipped = %d; got %d", want, have)
+	}
+	if want, have := 1, res.Shards.Failed; want != have {
+		t.Errorf("expected Shards.Failed = %d; got %d", want, have)
+	}
+	if want, have := 1, len(res.Shards.Failures); want != have {
+		t.Fatalf("expected %d shard failures; got %d", want, have)
+	}
+	if want, have := "node_not_connected_exception", res.Shards.Failures[0].Reason["type"]; want != have {
+		t.Errorf("expected failure reason type %q; got %v", want, have)
+	}
+	if want, have := 1, len(res.Suggest["song-suggest"]); want != have {
+		t.Errorf("expected %d suggestions; got %d", want, have)
+	}
+}
+
+func TestSearchWithMultipleCompletionSuggesters(t *testing.T) {
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"took": 1,
+			"timed_out": false,
+			"_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0},
+			"hits": {"total": {"value": 0, "relation": "eq"}, "max_score": null, "hits": []},
+			"suggest": {
+				"artist": [{"text": "nir", "offset": 0, "length": 3, "options": [{"text": "Nirvana", "_id": "1", "_score": 1.0}]}],
+				"album": [{"text": "nev", "offset": 0, "length": 3, "options": [{"text": "Nevermind", "_id": "2", "_score": 1.0}]}],
+				"song": [{"text": "smel", "offset": 0, "length": 4, "options": []}]
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Search().
+		Index("music").
+		Suggester(NewCompletionSuggester("artist").Prefix("nir").Field("artist_suggest")).
+		Suggester(NewCompletionSuggester("album").Prefix("nev").Field("album_suggest")).
+		Suggester(NewCompletionSuggester("song").Prefix("smel").Field("song_suggest")).
+		Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suggest, ok := body["suggest"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected suggest in request body; got %v", body)
+	}
+	for _, name := range []string{"artist", "album", "song"} {
+		if _, found := suggest[name]; !found {
+			t.Errorf("expected suggester %q in request body", name)
+		}
+		if _, found := res.Suggest[name]; !found {
+			t.Errorf("expected to find SearchResult.Suggest[%s]; got false", name)
+		}
+	}
+	if want, have := 3, len(res.Suggest); want != have {
+		t.Errorf("expected %d suggestion groups; got %d", want, have)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dhan-yext/elastic/uritemplates"
+)
+
+// SearchTemplateService executes a search based on a mustache template,
+// either specified inline or by the id of a stored template.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.0/search-template.html.
+type SearchTemplateService struct {
+	client            *Client
+	pretty            bool
+	index             []string
+	id                string
+	source            interface{}
+	params            map[string]interface{}
+	explain           *bool
+	profile           *bool
+	render            bool
+	routing           string
+	preference        string
+	ignoreUnavailable *bool
+	allowNoIndices    *bool
+	expandWildcards   string
+	headers           http.Header
+}
+
+// NewSearchTemplateService creates a new SearchTemplateService.
+func NewSearchTemplateService(client *Client) *SearchTemplateService {
+	return &SearchTemplateService{
+		client: client,
+	}
+}
+
+// Index sets the names of the indices to search.
+func (s *SearchTemplateService) Index(index ...string) *SearchTemplateService {
+	s.index = append(s.index, index...)
+	return s
+}
+
+// Id is the id of a stored template to use.
+func (s *SearchTemplateService) Id(id string) *SearchTemplateService {
+	s.id = id
+	return s
+}
+
+// Source specifies an inline template. It is either a string, e.g.
+// the template serialized as JSON, or a map[string]interface{}.
+func (s *SearchTemplateService) Source(source interface{}) *SearchTemplateService {
+	s.source = source
+	return s
+}
+
+// Params sets the parameters used to render the template.
+func (s *SearchTemplateService) Params(params map[string]interface{}) *SearchTemplateService {
+	s.params = params
+	return s
+}
+
+// Param adds a single parameter used to render the template.
+func (s *SearchTemplateService) Param(name string, value interface{}) *SearchTemplateService {
+	if s.params == nil {
+		s.params = make(map[string]interface{})
+	}
+	s.params[name] = value
+	return s
+}
+
+// Explain indicates whether to return detailed information about
+// score computation as part of a hit.
+func (s *SearchTemplateService) Explain(explain bool) *SearchTemplateService {
+	s.explain = &explain
+	return s
+}
+
+// Profile indicates whether to profile the query execution.
+func (s *SearchTemplateService) Profile(profile bool) *SearchTemplateService {
+	s.profile = &profile
+	return s
+}
+
+// Render indicates to only render the template via the /_render/template
+// endpoint instead of executing the search. The generated query is returned
+// in SearchTemplateResult.TemplateOutput.
+func (s *SearchTemplateService) Render(render bool) *SearchTemplateService {
+	s.render = render
+	return s
+}
+
+// Routing is a list of specific routing values.
+func (s *SearchTemplateService) Routing(routing string) *SearchTemplateService {
+	s.routing = routing
+	return s
+}
+
+// Preference specifies the node or shard the operation should be
+// performed on (default: random).
+func (s *SearchTemplateService) Preference(preference string) *SearchTemplateService {
+	s.preference = preference
+	return s
+}
+
+// IgnoreUnavailable indicates whether the specified concrete indices
+// should be ignored when unavailable (missing or closed).
+func (s *SearchTemplateService) IgnoreUnavailable(ignoreUnavailable bool) *SearchTemplateService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices
+// expression resolves into no concrete indices. (This includes `_all` string
+// or when no indices have been specified).
+func (s *SearchTemplateService) AllowNoIndices(allowNoIndices bool) *SearchTemplateService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards indicates whether to expand wildcard expression to
+// concrete indices that are open, closed or both.
+func (s *SearchTemplateService) ExpandWildcards(expandWildcards string) *SearchTemplateService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// Header sets headers on the request
+func (s *SearchTemplateService) Header(name string, value string) *SearchTemplateService {
+	if s.headers == nil {
+		s.headers = http.Header{}
+	}
+	s.headers.Add(name, value)
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *SearchTemplateService) Pretty(pretty bool) *SearchTemplateService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *SearchTemplateService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+	// Build URL
+	if s.render {
+		path = "/_render/template"
+	} else if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_search/template", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_search/template"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "true")
+	}
+	if s.render {
+		return path, params, nil
+	}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *SearchTemplateService) Validate() error {
+	var invalid []string
+	if s.id == "" && s.source == nil {
+		invalid = append(invalid, "Id || Source")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// body returns the request body.
+func (s *SearchTemplateService) body() interface{} {
+	body := make(map[string]interface{})
+	if s.id != "" {
+		body["id"] = s.id
+	}
+	if s.source != nil {
+		body["source"] = s.source
+	}
+	if len(s.params) > 0 {
+		body["params"] = s.params
+	}
+	if !s.render {
+		if s.explain != nil {
+			body["explain"] = *s.explain
+		}
+		if s.profile != nil {
+			body["profile"] = *s.profile
+		}
+	}
+	return body
+}
+
+// Do executes the operation. If Render is set, the search is not executed
+// and only TemplateOutput of the result is populated.
+func (s *SearchTemplateService) Do(ctx context.Context) (*SearchTemplateResult, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, PerformRequestOptions{
+		Method:  "POST",
+		Path:    path,
+		Params:  params,
+		Body:    s.body(),
+		Headers: s.headers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(SearchTemplateResult)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SearchTemplateResult is the outcome of SearchTemplateService.Do.
+type SearchTemplateResult struct {
+	SearchResult
+
+	// TemplateOutput is the rendered query. It is only set when
+	// SearchTemplateService.Render is used.
+	TemplateOutput map[string]interface{} `json:"template_output,omitempty"`
+}
@@ -0,0 +1,177 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchTemplateBuildURL(t *testing.T) {
+	tests := []struct {
+		Indices  []string
+		Render   bool
+		Expected string
+	}{
+		{
+			[]string{},
+			false,
+			"/_search/template",
+		},
+		{
+			[]string{"index1"},
+			false,
+			"/index1/_search/template",
+		},
+		{
+			[]string{"index1", "index2"},
+			false,
+			"/index1%2Cindex2/_search/template",
+		},
+		{
+			[]string{"index1"},
+			true,
+			"/_render/template",
+		},
+	}
+
+	for i, test := range tests {
+		path, _, err := NewSearchTemplateService(nil).Index(test.Indices...).Id("my-template").Render(test.Render).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.Expected {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.Expected, path)
+		}
+	}
+}
+
+func TestSearchTemplateValidate(t *testing.T) {
+	// No inline template and no stored template id
+	err := NewSearchTemplateService(nil).Index("tweets").Param("user", "olivere").Validate()
+	if err == nil {
+		t.Fatal("expected Validate to fail")
+	}
+	if err := NewSearchTemplateService(nil).Index("tweets").Id("my-template").Validate(); err != nil {
+		t.Fatalf("expected Validate to succeed; got: %v", err)
+	}
+}
+
+func TestSearchTemplateInline(t *testing.T) {
+	var (
+		path string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"took": 2,
+			"timed_out": false,
+			"_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0},
+			"hits": {
+				"total": {"value": 1, "relation": "eq"},
+				"max_score": 1.0,
+				"hits": [
+					{"_index": "tweets", "_type": "_doc", "_id": "1", "_score": 1.0, "_source": {"user": "olivere"}}
+				]
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SearchTemplate("tweets").
+		Source(`{"query":{"term":{"user":"{{user}}"}},"size":"{{size}}"}`).
+		Params(map[string]interface{}{"user": "olivere", "size": 5}).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/tweets/_search/template", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"params":{"size":5,"user":"olivere"},"source":"{\"query\":{\"term\":{\"user\":\"{{user}}\"}},\"size\":\"{{size}}\"}"}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if want, have := int64(1), res.TotalHits(); want != have {
+		t.Errorf("expected TotalHits() = %d; got: %d", want, have)
+	}
+	if len(res.Hits.Hits) != 1 {
+		t.Fatalf("expected %d hits; got: %d", 1, len(res.Hits.Hits))
+	}
+	if want, have := "1", res.Hits.Hits[0].Id; want != have {
+		t.Errorf("expected Hits[0].Id = %q; got: %q", want, have)
+	}
+	if res.TemplateOutput != nil {
+		t.Errorf("expected no template output; got: %v", res.TemplateOutput)
+	}
+}
+
+func TestSearchTemplateRender(t *testing.T) {
+	var (
+		path string
+		body string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"template_output":{"query":{"term":{"user":"olivere"}},"size":"5"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SearchTemplate("tweets").
+		Id("my-template").
+		Param("user", "olivere").
+		Param("size", 5).
+		Explain(true).
+		Render(true).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_render/template", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"id":"my-template","params":{"size":5,"user":"olivere"}}`, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if res.TemplateOutput == nil {
+		t.Fatal("expected template output; got nil")
+	}
+	if want, have := "5", res.TemplateOutput["size"]; want != have {
+		t.Errorf("expected template_output.size = %q; got: %v", want, have)
+	}
+	query, ok := res.TemplateOutput["query"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected template_output.query to be a map; got: %T", res.TemplateOutput["query"])
+	}
+	term, ok := query["term"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected template_output.query.term to be a map; got: %T", query["term"])
+	}
+	if want, have := "olivere", term["user"]; want != have {
+		t.Errorf("expected template_output.query.term.user = %q; got: %v", want, have)
+	}
+	if res.Hits != nil {
+		t.Errorf("expected no hits; got: %v", res.Hits)
+	}
+}
@@ -8,7 +8,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -121,6 +126,80 @@ func TestSearchResultTotalHits(t *testing.T) {
 	}
 }
 
+func TestSearchRequestAndResponseDecoding(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"took": 3,
+			"timed_out": false,
+			"hits": {
+				"total": {"value": 2, "relation": "eq"},
+				"max_score": 1.5,
+				"hits": [
+					{"_index": "tweets", "_id": "1", "_score": 1.5, "_source": {"user": "olivere"}},
+					{"_index": "tweets", "_id": "2", "_score": 0.5, "_source": {"user": "sandrae"}}
+				]
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Search().
+		Index("tweets").
+		Query(NewTermQuery("user", "olivere")).
+		From(10).
+		Size(5).
+		Sort("retweets", false).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_search", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"from":10,"query":{"term":{"user":"olivere"}},"size":5,"sort":[{"retweets":{"order":"desc"}}]}`
+	if want, have := expected, strings.TrimSpace(body); want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+
+	if want, have := int64(2), res.TotalHits(); want != have {
+		t.Fatalf("expected %d hits; got: %d", want, have)
+	}
+	if res.Hits.MaxScore == nil || *res.Hits.MaxScore != 1.5 {
+		t.Errorf("expected max score 1.5; got: %v", res.Hits.MaxScore)
+	}
+	if want, have := 2, len(res.Hits.Hits); want != have {
+		t.Fatalf("expected %d hits; got: %d", want, have)
+	}
+	hit := res.Hits.Hits[0]
+	if want, have := "1", hit.Id; want != have {
+		t.Errorf("expected _id %q; got: %q", want, have)
+	}
+	if hit.Score == nil || *hit.Score != 1.5 {
+		t.Errorf("expected _score 1.5; got: %v", hit.Score)
+	}
+	if want, have := `{"user": "olivere"}`, string(hit.Source); want != have {
+		t.Errorf("expected _source %s; got: %s", want, have)
+	}
+}
+
 func TestSearchResultWithProfiling(t *testing.T) {
 	client := setupTestClientAndCreateIndexAndAddDocs(t)
 
@@ -1088,6 +1167,423 @@ func TestSearchBuildURL(t *testing.T) {
 	}
 }
 
+func TestSearchBuildURLWithFilterPath(t *testing.T) {
+	tests := []struct {
+		FilterPath []string
+		Expected   string
+	}{
+		{
+			[]string{"suggest.mysuggest.options.text"},
+			"suggest.mysuggest.options.text",
+		},
+		{
+			[]string{"took", "suggest.*.options.text"},
+			"took,suggest.*.options.text",
+		},
+	}
+
+	for i, test := range tests {
+		_, params, err := NewSearchService(nil).Index("songs").FilterPath(test.FilterPath...).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if want, have := test.Expected, params.Get("filter_path"); want != have {
+			t.Errorf("case #%d: expected filter_path=%q; got: %q", i+1, want, have)
+		}
+	}
+
+	_, params, err := NewSearchService(nil).FilterPath("suggest.mysuggest.options.text").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "filter_path=suggest.mysuggest.options.text", params.Encode(); want != have {
+		t.Errorf("expected query string %q; got: %q", want, have)
+	}
+}
+
+func TestSearchBuildURLWithCustomParams(t *testing.T) {
+	_, params, err := NewSearchService(nil).
+		Param("error_trace", "true").
+		Params(url.Values{"human": []string{"true"}, "preference": []string{"_custom"}}).
+		Preference("_local").
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "error_trace=true&human=true&preference=_local", params.Encode(); want != have {
+		t.Errorf("expected query string %q; got: %q", want, have)
+	}
+}
+
+func TestSearchServiceFetchSource(t *testing.T) {
+	tests := []struct {
+		Service  *SearchService
+		Expected string
+	}{
+		{
+			NewSearchService(nil).Query(NewMatchAllQuery()).FetchSource(false),
+			`{"_source":false,"query":{"match_all":{}}}`,
+		},
+		{
+			NewSearchService(nil).Query(NewMatchAllQuery()).FetchSourceIncludeExclude([]string{"user", "obj.*"}, []string{"*.description"}),
+			`{"_source":{"excludes":["*.description"],"includes":["user","obj.*"]},"query":{"match_all":{}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Service.searchSource.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
+
+func TestSearchServicePostFilterWithAggregations(t *testing.T) {
+	svc := NewSearchService(nil).
+		Query(NewMatchQuery("message", "golang")).
+		Aggregation("colors", NewTermsAggregation().Field("color")).
+		PostFilter(NewTermQuery("color", "red"))
+	src, err := svc.searchSource.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	// post_filter must be a top-level sibling of query and aggregations,
+	// i.e. it must not be merged into the query that feeds the buckets.
+	expected := `{"aggregations":{"colors":{"terms":{"field":"color"}}},"post_filter":{"term":{"color":"red"}},"query":{"match":{"message":"golang"}}}`
+	if want, have := expected, string(data); want != have {
+		t.Errorf("expected\n%s\n,got:\n%s", want, have)
+	}
+}
+
+func TestSearchServiceRescorer(t *testing.T) {
+	tests := []struct {
+		Service  *SearchService
+		Expected string
+	}{
+		{
+			NewSearchService(nil).
+				Query(NewMatchQuery("message", "golang")).
+				Rescorer(NewRescore().WindowSize(50).Rescorer(
+					NewQueryRescorer(NewMatchPhraseQuery("message", "golang rocks")).
+						QueryWeight(0.7).
+						RescoreQueryWeight(1.2),
+				)),
+			`{"query":{"match":{"message":"golang"}},"rescore":{"query":{"query_weight":0.7,"rescore_query":{"match_phrase":{"message":{"query":"golang rocks"}}},"rescore_query_weight":1.2},"window_size":50}}`,
+		},
+		{
+			NewSearchService(nil).
+				Query(NewMatchQuery("message", "golang")).
+				Rescorer(NewRescore().WindowSize(100).Rescorer(
+					NewQueryRescorer(NewMatchPhraseQuery("message", "golang rocks")),
+				)).
+				Rescorer(NewRescore().WindowSize(10).Rescorer(
+					NewQueryRescorer(NewTermQuery("user", "olivere")).ScoreMode("multiply"),
+				)),
+			`{"query":{"match":{"message":"golang"}},"rescore":[{"query":{"rescore_query":{"match_phrase":{"message":{"query":"golang rocks"}}}},"window_size":100},{"query":{"rescore_query":{"term":{"user":"olivere"}},"score_mode":"multiply"},"window_size":10}]}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Service.searchSource.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
+
+func TestSearchServiceSearchAfter(t *testing.T) {
+	svc := NewSearchService(nil).
+		Query(NewMatchAllQuery()).
+		Sort("created", false).
+		Sort("_id", true).
+		SearchAfter(1463538857, "tweet#654323")
+	if err := svc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	src, err := svc.searchSource.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	expected := `{"query":{"match_all":{}},"search_after":[1463538857,"tweet#654323"],"sort":[{"created":{"order":"desc"}},{"_id":{"order":"asc"}}]}`
+	if want, have := expected, string(data); want != have {
+		t.Errorf("expected\n%s\n,got:\n%s", want, have)
+	}
+}
+
+func TestSearchServiceSearchAfterWithoutSort(t *testing.T) {
+	client, err := NewSimpleClient(SetURL("http://127.0.0.1:9/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Search("tweets").
+		Query(NewMatchAllQuery()).
+		SearchAfter(1463538857, "tweet#654323").
+		Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, have := "search_after requires a sort to be specified", err.Error(); want != have {
+		t.Errorf("expected error %q; got: %q", want, have)
+	}
+}
+
+func TestSearchServiceTrackTotalHits(t *testing.T) {
+	tests := []struct {
+		TrackTotalHits interface{}
+		Expected       string
+		Response       string
+		TotalHits      int64
+	}{
+		{
+			false,
+			`{"query":{"match_all":{}},"track_total_hits":false}`,
+			`{"took":1,"hits":{"max_score":1,"hits":[]}}`,
+			-1,
+		},
+		{
+			true,
+			`{"query":{"match_all":{}},"track_total_hits":true}`,
+			`{"took":1,"hits":{"total":{"value":12345,"relation":"eq"},"max_score":1,"hits":[]}}`,
+			12345,
+		},
+		{
+			100,
+			`{"query":{"match_all":{}},"track_total_hits":100}`,
+			`{"took":1,"hits":{"total":{"value":100,"relation":"gte"},"max_score":1,"hits":[]}}`,
+			100,
+		},
+	}
+
+	for i, tt := range tests {
+		var body string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := ioutil.ReadAll(r.Body)
+			body = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tt.Response))
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		res, err := client.Search("tweets").
+			Query(NewMatchAllQuery()).
+			TrackTotalHits(tt.TrackTotalHits).
+			Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want, have := tt.Expected, body; want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+		if want, have := tt.TotalHits, res.TotalHits(); want != have {
+			t.Errorf("#%d: expected SearchResult.TotalHits() = %d; got %d", i, want, have)
+		}
+	}
+}
+
+func TestSearchServiceMinScore(t *testing.T) {
+	tests := []struct {
+		Service  *SearchService
+		Expected string
+	}{
+		{
+			NewSearchService(nil).Query(NewMatchAllQuery()),
+			`{"query":{"match_all":{}}}`,
+		},
+		{
+			NewSearchService(nil).Query(NewMatchAllQuery()).MinScore(0),
+			`{"min_score":0,"query":{"match_all":{}}}`,
+		},
+		{
+			NewSearchService(nil).Query(NewMatchAllQuery()).MinScore(0.5),
+			`{"min_score":0.5,"query":{"match_all":{}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Service.searchSource.Source()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if want, have := tt.Expected, string(data); want != have {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, have)
+		}
+	}
+}
+
+func TestSearchServiceTerminateAfter(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"timed_out":false,"terminated_early":true,"hits":{"total":{"value":1,"relation":"eq"},"max_score":1,"hits":[{"_index":"tweets","_id":"1","_score":1,"_source":{"user":"olivere"}}]}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Search("tweets").
+		Query(NewTermQuery("user", "olivere")).
+		TerminateAfter(1).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := `{"query":{"term":{"user":"olivere"}},"terminate_after":1}`, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if !res.TerminatedEarly {
+		t.Errorf("expected SearchResult.TerminatedEarly = %v; got %v", true, res.TerminatedEarly)
+	}
+	if want, have := int64(1), res.TotalHits(); want != have {
+		t.Errorf("expected SearchResult.TotalHits() = %d; got %d", want, have)
+	}
+}
+
+func TestSearchServiceCollapse(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"took": 3,
+			"timed_out": false,
+			"hits": {
+				"total": {"value": 3, "relation": "eq"},
+				"max_score": null,
+				"hits": [
+					{
+						"_index": "tweets", "_id": "3", "_score": null, "sort": [3],
+						"_source": {"user": "olivere", "retweets": 3},
+						"fields": {"user": ["olivere"]},
+						"inner_hits": {
+							"most_retweeted": {
+								"hits": {
+									"total": {"value": 2, "relation": "eq"},
+									"max_score": null,
+									"hits": [
+										{"_index": "tweets", "_id": "3", "_score": null, "_source": {"user": "olivere", "retweets": 3}},
+										{"_index": "tweets", "_id": "1", "_score": null, "_source": {"user": "olivere", "retweets": 1}}
+									]
+								}
+							}
+						}
+					},
+					{
+						"_index": "tweets", "_id": "2", "_score": null, "sort": [2],
+						"_source": {"user": "sandrae", "retweets": 2},
+						"fields": {"user": ["sandrae"]},
+						"inner_hits": {
+							"most_retweeted": {
+								"hits": {
+									"total": {"value": 1, "relation": "eq"},
+									"max_score": null,
+									"hits": [
+										{"_index": "tweets", "_id": "2", "_score": null, "_source": {"user": "sandrae", "retweets": 2}}
+									]
+								}
+							}
+						}
+					}
+				]
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Search("tweets").
+		Query(NewMatchAllQuery()).
+		Sort("retweets", false).
+		Collapse(NewCollapseBuilder("user").
+			InnerHit(NewInnerHit().Name("most_retweeted").Size(2).Sort("retweets", false)).
+			MaxConcurrentGroupRequests(4)).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"collapse":{"field":"user","inner_hits":{"name":"most_retweeted","size":2,"sort":[{"retweets":{"order":"desc"}}]},"max_concurrent_group_searches":4},"query":{"match_all":{}},"sort":[{"retweets":{"order":"desc"}}]}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if res.Hits == nil {
+		t.Fatal("expected SearchResult.Hits != nil; got nil")
+	}
+	if want, have := 2, len(res.Hits.Hits); want != have {
+		t.Fatalf("expected %d collapsed hits; got: %d", want, have)
+	}
+
+	groups := []struct {
+		User string
+		Ids  []string
+	}{
+		{"olivere", []string{"3", "1"}},
+		{"sandrae", []string{"2"}},
+	}
+	for i, group := range groups {
+		hit := res.Hits.Hits[i]
+		users, ok := hit.Fields["user"].([]interface{})
+		if !ok || len(users) != 1 {
+			t.Fatalf("#%d: expected a single collapse key; got: %v", i, hit.Fields["user"])
+		}
+		if want, have := group.User, users[0]; want != have {
+			t.Errorf("#%d: expected collapse key %q; got: %v", i, want, have)
+		}
+		inner, found := hit.InnerHits["most_retweeted"]
+		if !found || inner == nil || inner.Hits == nil {
+			t.Fatalf("#%d: expected inner hits %q", i, "most_retweeted")
+		}
+		if want, have := int64(len(group.Ids)), inner.Hits.TotalHits.Value; want != have {
+			t.Errorf("#%d: expected %d inner hits in total; got: %d", i, want, have)
+		}
+		var ids []string
+		for _, innerHit := range inner.Hits.Hits {
+			ids = append(ids, innerHit.Id)
+		}
+		if want, have := strings.Join(group.Ids, ","), strings.Join(ids, ","); want != have {
+			t.Errorf("#%d: expected inner hit ids %s; got: %s", i, want, have)
+		}
+	}
+}
+
 func TestSearchFilterPath(t *testing.T) {
 	// client := setupTestClientAndCreateIndexAndAddDocs(t, SetTraceLog(log.New(os.Stdout, "", log.LstdFlags)))
 	client := setupTestClientAndCreateIndexAndAddDocs(t)
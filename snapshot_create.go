@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/dhan-yext/elastic/uritemplates"
 )
@@ -21,6 +22,7 @@ type SnapshotCreateService struct {
 	snapshot          string
 	masterTimeout     string
 	waitForCompletion *bool
+	indices           []string
 	bodyJson          interface{}
 	bodyString        string
 }
@@ -62,6 +64,13 @@ func (s *SnapshotCreateService) Pretty(pretty bool) *SnapshotCreateService {
 	return s
 }
 
+// Indices sets the indices to include in the snapshot. It is ignored
+// if the snapshot definition is specified via BodyJson or BodyString.
+func (s *SnapshotCreateService) Indices(indices ...string) *SnapshotCreateService {
+	s.indices = append(s.indices, indices...)
+	return s
+}
+
 // BodyJson is documented as: The snapshot definition.
 func (s *SnapshotCreateService) BodyJson(body interface{}) *SnapshotCreateService {
 	s.bodyJson = body
@@ -127,20 +136,12 @@ func (s *SnapshotCreateService) Do(ctx context.Context) (*SnapshotCreateResponse
 		return nil, err
 	}
 
-	// Setup HTTP request body
-	var body interface{}
-	if s.bodyJson != nil {
-		body = s.bodyJson
-	} else {
-		body = s.bodyString
-	}
-
 	// Get HTTP response
 	res, err := s.client.PerformRequest(ctx, PerformRequestOptions{
 		Method: "PUT",
 		Path:   path,
 		Params: params,
-		Body:   body,
+		Body:   s.buildBody(),
 	})
 	if err != nil {
 		return nil, err
@@ -154,6 +155,19 @@ func (s *SnapshotCreateService) Do(ctx context.Context) (*SnapshotCreateResponse
 	return ret, nil
 }
 
+// buildBody returns the snapshot definition.
+func (s *SnapshotCreateService) buildBody() interface{} {
+	if s.bodyJson != nil {
+		return s.bodyJson
+	}
+	if s.bodyString != "" || len(s.indices) == 0 {
+		return s.bodyString
+	}
+	return map[string]interface{}{
+		"indices": strings.Join(s.indices, ","),
+	}
+}
+
 // SnapshotShardFailure stores information about failures that occurred during shard snapshotting process.
 type SnapshotShardFailure struct {
 	Index     string `json:"index"`
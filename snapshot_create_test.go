@@ -1,6 +1,10 @@
 package elastic
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"testing"
@@ -61,3 +65,98 @@ func TestSnapshotPutURL(t *testing.T) {
 		}
 	}
 }
+
+func TestSnapshotCreateWithIndicesAndWaitForCompletion(t *testing.T) {
+	var (
+		method string
+		uri    string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"snapshot": {
+				"snapshot": "snapshot_of_sunday",
+				"uuid": "dKb54xw67gvdRctLCxSket",
+				"indices": ["index_1", "index_2"],
+				"state": "SUCCESS",
+				"start_time_in_millis": 1562511780000,
+				"end_time_in_millis": 1562511781000,
+				"duration_in_millis": 1000,
+				"failures": [],
+				"shards": {"total": 2, "failed": 0, "successful": 2}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SnapshotCreate("repo", "snapshot_of_sunday").
+		Indices("index_1", "index_2").
+		WaitForCompletion(true).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "PUT", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/_snapshot/repo/snapshot_of_sunday?wait_for_completion=true", uri; want != have {
+		t.Errorf("expected URL %q; got: %q", want, have)
+	}
+	if want, have := `{"indices":"index_1,index_2"}`, body; want != have {
+		t.Errorf("expected body\n%s\n,got:\n%s", want, have)
+	}
+	if res.Accepted != nil {
+		t.Errorf("expected Accepted = nil; got: %v", *res.Accepted)
+	}
+	if res.Snapshot == nil {
+		t.Fatal("expected snapshot; got nil")
+	}
+	if want, have := "SUCCESS", res.Snapshot.State; want != have {
+		t.Errorf("expected State = %q; got: %q", want, have)
+	}
+	if want, have := []string{"index_1", "index_2"}, res.Snapshot.Indices; !reflect.DeepEqual(want, have) {
+		t.Errorf("expected Indices = %v; got: %v", want, have)
+	}
+	if res.Snapshot.Shards == nil || res.Snapshot.Shards.Successful != 2 {
+		t.Errorf("expected 2 successful shards; got: %+v", res.Snapshot.Shards)
+	}
+}
+
+func TestSnapshotCreateBuildBody(t *testing.T) {
+	tests := []struct {
+		Service  *SnapshotCreateService
+		Expected interface{}
+	}{
+		{
+			NewSnapshotCreateService(nil),
+			"",
+		},
+		{
+			NewSnapshotCreateService(nil).Indices("index_1"),
+			map[string]interface{}{"indices": "index_1"},
+		},
+		{
+			NewSnapshotCreateService(nil).Indices("index_1").BodyJson(map[string]interface{}{"indices": "index_2"}),
+			map[string]interface{}{"indices": "index_2"},
+		},
+		{
+			NewSnapshotCreateService(nil).Indices("index_1").BodyString(`{"indices":"index_3"}`),
+			`{"indices":"index_3"}`,
+		},
+	}
+
+	for i, tt := range tests {
+		if want, have := tt.Expected, tt.Service.buildBody(); !reflect.DeepEqual(want, have) {
+			t.Errorf("case #%d: expected body %v; got: %v", i+1, want, have)
+		}
+	}
+}
@@ -74,6 +74,38 @@ func (q *CompletionSuggester) Fuzziness(fuzziness interface{}) *CompletionSugges
 	return q
 }
 
+func (q *CompletionSuggester) FuzzyTranspositions(transpositions bool) *CompletionSuggester {
+	if q.fuzzyOptions == nil {
+		q.fuzzyOptions = NewFuzzyCompletionSuggesterOptions()
+	}
+	q.fuzzyOptions = q.fuzzyOptions.Transpositions(transpositions)
+	return q
+}
+
+func (q *CompletionSuggester) FuzzyMinLength(minLength int) *CompletionSuggester {
+	if q.fuzzyOptions == nil {
+		q.fuzzyOptions = NewFuzzyCompletionSuggesterOptions()
+	}
+	q.fuzzyOptions = q.fuzzyOptions.MinLength(minLength)
+	return q
+}
+
+func (q *CompletionSuggester) FuzzyPrefixLength(prefixLength int) *CompletionSuggester {
+	if q.fuzzyOptions == nil {
+		q.fuzzyOptions = NewFuzzyCompletionSuggesterOptions()
+	}
+	q.fuzzyOptions = q.fuzzyOptions.PrefixLength(prefixLength)
+	return q
+}
+
+func (q *CompletionSuggester) UnicodeAware(unicodeAware bool) *CompletionSuggester {
+	if q.fuzzyOptions == nil {
+		q.fuzzyOptions = NewFuzzyCompletionSuggesterOptions()
+	}
+	q.fuzzyOptions = q.fuzzyOptions.UnicodeAware(unicodeAware)
+	return q
+}
+
 func (q *CompletionSuggester) Regex(regex string) *CompletionSuggester {
 	q.regex = regex
 	return q
@@ -110,6 +142,9 @@ func (q *CompletionSuggester) Size(size int) *CompletionSuggester {
 	return q
 }
 
+// ShardSize sets the maximum number of suggestions retrieved from each
+// individual shard. Elasticsearch defaults it to Size; it is only sent
+// if it is greater than zero.
 func (q *CompletionSuggester) ShardSize(shardSize int) *CompletionSuggester {
 	q.shardSize = &shardSize
 	return q
@@ -162,7 +197,7 @@ func (q *CompletionSuggester) Source(includeName bool) (interface{}, error) {
 	if q.size != nil {
 		suggester["size"] = *q.size
 	}
-	if q.shardSize != nil {
+	if q.shardSize != nil && *q.shardSize > 0 {
 		suggester["shard_size"] = *q.shardSize
 	}
 	switch len(q.contextQueries) {
@@ -28,6 +28,47 @@ func TestCompletionSuggesterSource(t *testing.T) {
 	}
 }
 
+func TestCompletionSuggesterSourceWithSizeAndShardSize(t *testing.T) {
+	s := NewCompletionSuggester("song-suggest").
+		Text("n").
+		Field("suggest").
+		Size(5).
+		ShardSize(20)
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"song-suggest":{"text":"n","completion":{"field":"suggest","shard_size":20,"size":5}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestCompletionSuggesterSourceWithoutName(t *testing.T) {
+	s := NewCompletionSuggester("song-suggest").
+		Text("n").
+		Field("suggest").
+		Size(5)
+	src, err := s.Source(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"text":"n","completion":{"field":"suggest","size":5}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestCompletionSuggesterPrefixSource(t *testing.T) {
 	s := NewCompletionSuggester("song-suggest").
 		Prefix("nir").
@@ -67,6 +108,50 @@ func TestCompletionSuggesterPrefixWithFuzzySource(t *testing.T) {
 	}
 }
 
+func TestCompletionSuggesterFuzzinessSource(t *testing.T) {
+	s := NewCompletionSuggester("song-suggest").
+		Prefix("nor").
+		Field("suggest").
+		Fuzziness(2)
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"song-suggest":{"prefix":"nor","completion":{"field":"suggest","fuzzy":{"fuzziness":2}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestCompletionSuggesterAllFuzzyOptionsSource(t *testing.T) {
+	s := NewCompletionSuggester("song-suggest").
+		Prefix("nor").
+		Field("suggest").
+		Fuzziness("AUTO").
+		FuzzyTranspositions(false).
+		FuzzyMinLength(4).
+		FuzzyPrefixLength(2).
+		UnicodeAware(true)
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"song-suggest":{"prefix":"nor","completion":{"field":"suggest","fuzzy":{"fuzziness":"AUTO","min_length":4,"prefix_length":2,"transpositions":false,"unicode_aware":true}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestCompletionSuggesterRegexSource(t *testing.T) {
 	s := NewCompletionSuggester("song-suggest").
 		Regex("n[ever|i]r").
@@ -108,3 +193,26 @@ func TestCompletionSuggesterSourceWithMultipleContexts(t *testing.T) {
 		t.Errorf("expected %s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestCompletionSuggesterSourceWithCategoryAndGeoContexts(t *testing.T) {
+	s := NewCompletionSuggester("place-suggest").
+		Prefix("tim").
+		Field("suggest").
+		ContextQueries(
+			NewSuggesterCategoryQuery("place_type").ValueWithBoost("cafe", 2),
+			NewSuggesterGeoQuery("location", GeoPointFromLatLon(43.662, -79.380)).Precision("2km").Neighbours("5km"),
+		)
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"place-suggest":{"prefix":"tim","completion":{"contexts":{"location":{"context":{"lat":43.662,"lon":-79.38},"neighbours":"5km","precision":"2km"},"place_type":[{"boost":2,"context":"cafe"}]},"field":"suggest"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -21,6 +21,7 @@ type ContextSuggester struct {
 	prefix         string
 	field          string
 	size           *int
+	shardSize      *int
 	contextQueries []SuggesterContextQuery
 }
 
@@ -51,6 +52,11 @@ func (q *ContextSuggester) Size(size int) *ContextSuggester {
 	return q
 }
 
+func (q *ContextSuggester) ShardSize(shardSize int) *ContextSuggester {
+	q.shardSize = &shardSize
+	return q
+}
+
 func (q *ContextSuggester) ContextQuery(query SuggesterContextQuery) *ContextSuggester {
 	q.contextQueries = append(q.contextQueries, query)
 	return q
@@ -87,6 +93,9 @@ func (q *ContextSuggester) Source(includeName bool) (interface{}, error) {
 	if q.size != nil {
 		suggester["size"] = *q.size
 	}
+	if q.shardSize != nil && *q.shardSize > 0 {
+		suggester["shard_size"] = *q.shardSize
+	}
 	switch len(q.contextQueries) {
 	case 0:
 	case 1:
@@ -179,7 +179,7 @@ func (q *PhraseSuggester) CollatePrune(collatePrune bool) *PhraseSuggester {
 // We got into trouble when using plain maps because the text element
 // needs to go before the simple_phrase element.
 type phraseSuggesterRequest struct {
-	Text   string      `json:"text"`
+	Text   string      `json:"text,omitempty"`
 	Phrase interface{} `json:"phrase"`
 }
 
@@ -203,7 +203,7 @@ func (q *PhraseSuggester) Source(includeName bool) (interface{}, error) {
 	if q.size != nil {
 		suggester["size"] = *q.size
 	}
-	if q.shardSize != nil {
+	if q.shardSize != nil && *q.shardSize > 0 {
 		suggester["shard_size"] = *q.shardSize
 	}
 	switch len(q.contextQueries) {
@@ -403,8 +403,8 @@ type CandidateGenerator interface {
 }
 
 // DirectCandidateGenerator implements a direct candidate generator.
-// See https://www.elastic.co/guide/en/elasticsearch/reference/7.0/search-suggesters-phrase.html#_smoothing_models
-// for details about smoothing models.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.0/search-suggesters-phrase.html#_direct_generators
+// for details about direct generators.
 type DirectCandidateGenerator struct {
 	field          string
 	preFilter      *string
@@ -107,6 +107,28 @@ func TestPhraseSuggesterComplexSource(t *testing.T) {
 	}
 }
 
+func TestPhraseSuggesterSourceWithSmoothingModel(t *testing.T) {
+	s := NewPhraseSuggester("name").
+		Text("Xor the Got-Jewel").
+		Field("bigram").
+		Separator(" ").
+		Confidence(1.5).
+		SmoothingModel(NewLaplaceSmoothingModel(0.7))
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"name":{"text":"Xor the Got-Jewel","phrase":{"confidence":1.5,"field":"bigram","separator":" ","smoothing":{"laplace":{"alpha":0.7}}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestPhraseStupidBackoffSmoothingModel(t *testing.T) {
 	s := NewStupidBackoffSmoothingModel(0.42)
 	src, err := s.Source()
@@ -199,3 +221,50 @@ func TestPhraseSuggesterSourceWithCollateQueryString(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestDirectCandidateGenerator(t *testing.T) {
+	g := NewDirectCandidateGenerator("body").
+		Size(5).
+		SuggestMode("popular").
+		MaxEdits(2).
+		PrefixLength(1).
+		MinWordLength(3).
+		MaxInspections(4).
+		MinDocFreq(0.01).
+		MaxTermFreq(0.5).
+		PreFilter("reverse").
+		PostFilter("reverse")
+	src, err := g.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"field":"body","max_edits":2,"max_inspections":4,"max_term_freq":0.5,"min_doc_freq":0.01,"min_word_length":3,"post_filter":"reverse","pre_filter":"reverse","prefix_length":1,"size":5,"suggest_mode":"popular"}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+	if g.Type() != "direct_generator" {
+		t.Errorf("expected %q, got: %q", "direct_generator", g.Type())
+	}
+}
+
+func TestDirectCandidateGeneratorWithOnlyField(t *testing.T) {
+	g := NewDirectCandidateGenerator("body")
+	src, err := g.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"field":"body"}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -28,6 +28,7 @@ type TermSuggester struct {
 	prefixLength   *int
 	minWordLength  *int
 	minDocFreq     *float64
+	lowercaseTerms *bool
 }
 
 // NewTermSuggester creates a new TermSuggester.
@@ -126,12 +127,19 @@ func (q *TermSuggester) MinDocFreq(minDocFreq float64) *TermSuggester {
 	return q
 }
 
+// LowercaseTerms, if true, lowercases the suggest text terms after
+// text analysis.
+func (q *TermSuggester) LowercaseTerms(lowercaseTerms bool) *TermSuggester {
+	q.lowercaseTerms = &lowercaseTerms
+	return q
+}
+
 // termSuggesterRequest is necessary because the order in which
 // the JSON elements are routed to Elasticsearch is relevant.
 // We got into trouble when using plain maps because the text element
 // needs to go before the term element.
 type termSuggesterRequest struct {
-	Text string      `json:"text"`
+	Text string      `json:"text,omitempty"`
 	Term interface{} `json:"term"`
 }
 
@@ -168,7 +176,7 @@ func (q *TermSuggester) Source(includeName bool) (interface{}, error) {
 	if q.size != nil {
 		suggester["size"] = *q.size
 	}
-	if q.shardSize != nil {
+	if q.shardSize != nil && *q.shardSize > 0 {
 		suggester["shard_size"] = *q.shardSize
 	}
 	switch len(q.contextQueries) {
@@ -222,6 +230,9 @@ func (q *TermSuggester) Source(includeName bool) (interface{}, error) {
 	if q.minDocFreq != nil {
 		suggester["min_doc_freq"] = *q.minDocFreq
 	}
+	if q.lowercaseTerms != nil {
+		suggester["lowercase_terms"] = *q.lowercaseTerms
+	}
 
 	if !includeName {
 		return ts, nil
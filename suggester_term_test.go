@@ -47,3 +47,56 @@ func TestTermSuggesterWithPrefixLengthSource(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTermSuggesterWithSuggestModeSource(t *testing.T) {
+	s := NewTermSuggester("name").
+		Text("n").
+		Field("suggest").
+		SuggestMode("popular")
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"name":{"text":"n","term":{"field":"suggest","suggest_mode":"popular"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestTermSuggesterWithAllOptionsSource(t *testing.T) {
+	s := NewTermSuggester("name").
+		Text("n").
+		Field("suggest").
+		Analyzer("standard").
+		Size(3).
+		ShardSize(10).
+		Sort("frequency").
+		SuggestMode("always").
+		MaxEdits(1).
+		PrefixLength(2).
+		MinWordLength(4).
+		MaxInspections(5).
+		MinDocFreq(0.01).
+		MaxTermFreq(0.02).
+		StringDistance("ngram").
+		Accuracy(0.7).
+		LowercaseTerms(true)
+	src, err := s.Source(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"name":{"text":"n","term":{"accuracy":0.7,"analyzer":"standard","field":"suggest","lowercase_terms":true,"max_edits":1,"max_inspections":5,"max_term_freq":0.02,"min_doc_freq":0.01,"min_word_len":4,"prefix_length":2,"shard_size":10,"size":3,"sort":"frequency","string_distance":"ngram","suggest_mode":"always"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSuggesterShardSize(t *testing.T) {
+	tests := []struct {
+		Suggester Suggester
+		Expected  string
+	}{
+		// Completion suggester
+		{
+			NewCompletionSuggester("s").Text("n").Field("suggest").Size(5),
+			`{"s":{"text":"n","completion":{"field":"suggest","size":5}}}`,
+		},
+		{
+			NewCompletionSuggester("s").Text("n").Field("suggest").Size(5).ShardSize(0),
+			`{"s":{"text":"n","completion":{"field":"suggest","size":5}}}`,
+		},
+		{
+			NewCompletionSuggester("s").Text("n").Field("suggest").Size(5).ShardSize(25),
+			`{"s":{"text":"n","completion":{"field":"suggest","shard_size":25,"size":5}}}`,
+		},
+		// Term suggester
+		{
+			NewTermSuggester("s").Text("n").Field("suggest").Size(5),
+			`{"s":{"text":"n","term":{"field":"suggest","size":5}}}`,
+		},
+		{
+			NewTermSuggester("s").Text("n").Field("suggest").Size(5).ShardSize(0),
+			`{"s":{"text":"n","term":{"field":"suggest","size":5}}}`,
+		},
+		{
+			NewTermSuggester("s").Text("n").Field("suggest").Size(5).ShardSize(25),
+			`{"s":{"text":"n","term":{"field":"suggest","shard_size":25,"size":5}}}`,
+		},
+		// Phrase suggester
+		{
+			NewPhraseSuggester("s").Text("n").Field("suggest").Size(5).ShardSize(25),
+			`{"s":{"text":"n","phrase":{"field":"suggest","shard_size":25,"size":5}}}`,
+		},
+		// Context suggester
+		{
+			NewContextSuggester("s").Prefix("n").Field("suggest").Size(5).ShardSize(25),
+			`{"s":{"prefix":"n","completion":{"field":"suggest","shard_size":25,"size":5}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		src, err := tt.Suggester.Source(true)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("#%d: marshaling to JSON failed: %v", i, err)
+		}
+		if got, want := string(data), tt.Expected; got != want {
+			t.Errorf("#%d: expected\n%s\n,got:\n%s", i, want, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package elastic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -122,7 +123,9 @@ func (s *TasksGetTaskService) Do(ctx context.Context) (*TasksGetTaskResponse, er
 }
 
 type TasksGetTaskResponse struct {
-	Header    http.Header `json:"-"`
-	Completed bool        `json:"completed"`
-	Task      *TaskInfo   `json:"task,omitempty"`
+	Header    http.Header     `json:"-"`
+	Completed bool            `json:"completed"`
+	Task      *TaskInfo       `json:"task,omitempty"`
+	Response  json.RawMessage `json:"response,omitempty"` // outcome of the task, e.g. a BulkIndexByScrollResponse for reindexing
+	Error     *ErrorDetails   `json:"error,omitempty"`
 }
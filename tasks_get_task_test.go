@@ -6,7 +6,12 @@ package elastic
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestTasksGetTaskBuildURL(t *testing.T) {
@@ -78,3 +83,162 @@ func TestTasksGetTask(t *testing.T) {
 		}
 	*/
 }
+
+const (
+	testTaskInProgress = `{
+		"completed": false,
+		"task": {
+			"node": "oTUltX4IQMOUUVeiohTt8A",
+			"id": 12345,
+			"type": "transport",
+			"action": "indices:data/write/reindex",
+			"status": {"total": 120, "created": 40},
+			"description": "reindex from [tweets-v1] to [tweets-v2]",
+			"start_time_in_millis": 1570000000000,
+			"running_time_in_nanos": 2000000000,
+			"cancellable": true
+		}
+	}`
+	testTaskCompleted = `{
+		"completed": true,
+		"task": {
+			"node": "oTUltX4IQMOUUVeiohTt8A",
+			"id": 12345,
+			"type": "transport",
+			"action": "indices:data/write/reindex",
+			"status": {"total": 120, "created": 120},
+			"description": "reindex from [tweets-v1] to [tweets-v2]",
+			"start_time_in_millis": 1570000000000,
+			"running_time_in_nanos": 5000000000,
+			"cancellable": true
+		},
+		"response": {
+			"took": 147,
+			"timed_out": false,
+			"total": 120,
+			"created": 120,
+			"batches": 1,
+			"version_conflicts": 0,
+			"failures": []
+		}
+	}`
+)
+
+func TestTasksGetTaskDecodeResponse(t *testing.T) {
+	tests := []struct {
+		Body      string
+		Completed bool
+	}{
+		{testTaskInProgress, false},
+		{testTaskCompleted, true},
+	}
+
+	for i, tt := range tests {
+		var res TasksGetTaskResponse
+		if err := json.Unmarshal([]byte(tt.Body), &res); err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := tt.Completed, res.Completed; want != have {
+			t.Errorf("case #%d: expected Completed = %v; got: %v", i+1, want, have)
+		}
+		if res.Task == nil {
+			t.Fatalf("case #%d: expected task; got nil", i+1)
+		}
+		if want, have := int64(12345), res.Task.Id; want != have {
+			t.Errorf("case #%d: expected Task.Id = %d; got: %d", i+1, want, have)
+		}
+		if want, have := "indices:data/write/reindex", res.Task.Action; want != have {
+			t.Errorf("case #%d: expected Task.Action = %q; got: %q", i+1, want, have)
+		}
+		if !tt.Completed {
+			if len(res.Response) > 0 {
+				t.Errorf("case #%d: expected no response; got: %s", i+1, string(res.Response))
+			}
+			continue
+		}
+		var outcome BulkIndexByScrollResponse
+		if err := json.Unmarshal(res.Response, &outcome); err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := int64(120), outcome.Created; want != have {
+			t.Errorf("case #%d: expected Created = %d; got: %d", i+1, want, have)
+		}
+	}
+}
+
+func TestWaitForTask(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "/_tasks/oTUltX4IQMOUUVeiohTt8A:12345", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&polls, 1) < 3 {
+			w.Write([]byte(testTaskInProgress))
+			return
+		}
+		w.Write([]byte(testTaskCompleted))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.WaitForTask(context.Background(), "oTUltX4IQMOUUVeiohTt8A:12345", 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Completed {
+		t.Fatalf("expected task to be completed")
+	}
+	if want, have := int32(3), atomic.LoadInt32(&polls); want != have {
+		t.Errorf("expected %d polls; got: %d", want, have)
+	}
+}
+
+func TestWaitForTaskWithCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testTaskInProgress))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForTask(ctx, "oTUltX4IQMOUUVeiohTt8A:12345", 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !IsContextErr(err) {
+		t.Errorf("expected context error; got: %v", err)
+	}
+}
+
+func TestWaitForTaskWithZeroPollInterval(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testTaskInProgress))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err = client.WaitForTask(context.Background(), "oTUltX4IQMOUUVeiohTt8A:12345", interval)
+		if err == nil {
+			t.Fatalf("expected error with poll interval %v", interval)
+		}
+	}
+	if want, have := int32(0), atomic.LoadInt32(&polls); want != have {
+		t.Errorf("expected %d polls; got: %d", want, have)
+	}
+}
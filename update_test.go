@@ -7,6 +7,10 @@ package elastic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -44,6 +48,125 @@ func TestUpdateViaScript(t *testing.T) {
 	}
 }
 
+func TestUpdateDo(t *testing.T) {
+	var (
+		method string
+		uri    string
+		body   string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		uri = r.URL.RequestURI()
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"1","_version":4,"result":"updated","_seq_no":3,"_primary_term":1}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Update().
+		Index("tweets").Id("1").
+		Script(NewScript("ctx._source.retweets += params.num").Param("num", 1)).
+		Upsert(map[string]interface{}{"retweets": 0}).
+		RetryOnConflict(3).
+		Refresh("true").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/tweets/_update/1?refresh=true&retry_on_conflict=3", uri; want != have {
+		t.Errorf("expected URI %q; got: %q", want, have)
+	}
+	expected := `{"script":{"params":{"num":1},"source":"ctx._source.retweets += params.num"},"upsert":{"retweets":0}}`
+	if want, have := expected, body; want != have {
+		t.Errorf("expected body\n%s\ngot:\n%s", want, have)
+	}
+	if want, have := int64(4), res.Version; want != have {
+		t.Errorf("expected _version = %d; got: %d", want, have)
+	}
+	if want, have := "updated", res.Result; want != have {
+		t.Errorf("expected result = %q; got: %q", want, have)
+	}
+}
+
+func TestUpdateWithIfSeqNoAndIfPrimaryTerm(t *testing.T) {
+	const (
+		currentSeqNo       = 3
+		currentPrimaryTerm = 1
+	)
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("if_seq_no") != fmt.Sprint(currentSeqNo) || query.Get("if_primary_term") != fmt.Sprint(currentPrimaryTerm) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"type":"version_conflict_engine_exception","reason":"[1]: version conflict, required seqNo [2], primary term [1]. current document has seqNo [3] and primary term [1]","index":"tweets"},"status":409}`))
+			return
+		}
+		w.Write([]byte(`{"_index":"tweets","_type":"_doc","_id":"1","_version":5,"result":"updated","_seq_no":4,"_primary_term":1}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewSimpleClient(SetURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Successful conditional update
+	res, err := client.Update().
+		Index("tweets").Id("1").
+		Doc(map[string]interface{}{"retweets": 42}).
+		IfSeqNo(currentSeqNo).
+		IfPrimaryTerm(currentPrimaryTerm).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "3", query.Get("if_seq_no"); want != have {
+		t.Errorf("expected if_seq_no = %q; got: %q", want, have)
+	}
+	if want, have := "1", query.Get("if_primary_term"); want != have {
+		t.Errorf("expected if_primary_term = %q; got: %q", want, have)
+	}
+	if want, have := int64(4), res.SeqNo; want != have {
+		t.Errorf("expected _seq_no = %d; got: %d", want, have)
+	}
+	if want, have := int64(1), res.PrimaryTerm; want != have {
+		t.Errorf("expected _primary_term = %d; got: %d", want, have)
+	}
+
+	// Stale sequence number
+	res, err = client.Update().
+		Index("tweets").Id("1").
+		Doc(map[string]interface{}{"retweets": 43}).
+		IfSeqNo(currentSeqNo - 1).
+		IfPrimaryTerm(currentPrimaryTerm).
+		Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if res != nil {
+		t.Errorf("expected no response; got: %+v", res)
+	}
+	if !IsConflict(err) {
+		t.Fatalf("expected conflict error; got: %v", err)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected *Error; got: %T", err)
+	}
+	if want, have := "version_conflict_engine_exception", e.Details.Type; want != have {
+		t.Errorf("expected error type %q; got: %q", want, have)
+	}
+}
+
 func TestUpdateViaScriptId(t *testing.T) {
 	client := setupTestClient(t) // , SetTraceLog(log.New(os.Stdout, "", 0)))
 
@@ -93,6 +93,23 @@ var expandTests = []ExpandTest{
 		// The double slashes here look weird, but it's intentional
 		"http://www.golang.org//topics/myproject/mytopic",
 	},
+	// #10: query and fragment delimiters must not leak out of the path
+	{
+		"http://www.golang.org/{index}/_search",
+		map[string]string{
+			"index": "my index?q=1#top",
+		},
+		"http://www.golang.org/my%20index%3Fq%3D1%23top/_search",
+	},
+	// #11: comma-separated lists are escaped as a whole
+	{
+		"http://www.golang.org/{index}/{type}/_search",
+		map[string]string{
+			"index": "a?,b c",
+			"type":  "t#1",
+		},
+		"http://www.golang.org/a%3F%2Cb%20c/t%231/_search",
+	},
 }
 
 func TestExpand(t *testing.T) {
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -52,3 +55,89 @@ func TestValidate(t *testing.T) {
 		t.Errorf("expected valid to be %v; got: %v", false, valid.Valid)
 	}
 }
+
+func TestValidateWithExplanations(t *testing.T) {
+	tests := []struct {
+		Response    string
+		Valid       bool
+		Explanation string
+		Error       string
+	}{
+		{
+			`{"_shards":{"total":1,"successful":1,"failed":0},"valid":true,"explanations":[{"index":"tweets","valid":true,"explanation":"user:olivere"}]}`,
+			true,
+			"user:olivere",
+			"",
+		},
+		{
+			`{"valid":false,"explanations":[{"index":"tweets","valid":false,"error":"[tweets/abc] QueryShardException[failed to create query: For input string: \"abc\"]"}]}`,
+			false,
+			"",
+			`[tweets/abc] QueryShardException[failed to create query: For input string: "abc"]`,
+		},
+	}
+
+	for i, tt := range tests {
+		var (
+			path  string
+			query string
+			body  string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			query = r.URL.RawQuery
+			data, _ := ioutil.ReadAll(r.Body)
+			body = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tt.Response))
+		}))
+
+		client, err := NewSimpleClient(SetURL(ts.URL))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+		explain, rewrite := true, false
+		res, err := client.Validate("tweets").
+			Query(NewTermQuery("user", "olivere")).
+			Explain(&explain).
+			Rewrite(&rewrite).
+			Do(context.TODO())
+		ts.Close()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := "/tweets/_validate/query", path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := "explain=true&rewrite=false", query; want != have {
+			t.Errorf("case #%d: expected query string %q; got: %q", i+1, want, have)
+		}
+		if want, have := `{"query":{"term":{"user":"olivere"}}}`, body; want != have {
+			t.Errorf("case #%d: expected body\n%s\n,got:\n%s", i+1, want, have)
+		}
+		if want, have := tt.Valid, res.Valid; want != have {
+			t.Errorf("case #%d: expected Valid = %v; got: %v", i+1, want, have)
+		}
+		if want, have := 1, len(res.Explanations); want != have {
+			t.Fatalf("case #%d: expected %d explanations; got: %d", i+1, want, have)
+		}
+		expl, ok := res.Explanations[0].(map[string]interface{})
+		if !ok {
+			t.Fatalf("case #%d: expected explanation to be a map; got: %T", i+1, res.Explanations[0])
+		}
+		if want, have := "tweets", expl["index"]; want != have {
+			t.Errorf("case #%d: expected index %q; got: %v", i+1, want, have)
+		}
+		if tt.Explanation != "" {
+			if want, have := tt.Explanation, expl["explanation"]; want != have {
+				t.Errorf("case #%d: expected explanation %q; got: %v", i+1, want, have)
+			}
+		}
+		if tt.Error != "" {
+			if want, have := tt.Error, expl["error"]; want != have {
+				t.Errorf("case #%d: expected error %q; got: %v", i+1, want, have)
+			}
+		}
+	}
+}